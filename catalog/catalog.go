@@ -0,0 +1,281 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getsynq/connections-tableau/model"
+	_ "modernc.org/sqlite"
+)
+
+// Catalog is a SQLite database holding normalised snapshots of exports.
+type Catalog struct {
+	db *sql.DB
+}
+
+// Open opens the catalog at path, creating the file and its tables if they do not exist yet.
+func Open(path string) (*Catalog, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create catalog tables: %w", err)
+	}
+	return &Catalog{db: db}, nil
+}
+
+func (c *Catalog) Close() error {
+	return c.db.Close()
+}
+
+// Write stores response as a new snapshot and returns its run ID.
+func (c *Catalog) Write(ctx context.Context, response *model.Response, url, site string) (int64, error) {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "INSERT INTO runs (extracted_at, url, site) VALUES (?, ?, ?)", timestamp(response.ExtractedAt), url, site)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create run: %w", err)
+	}
+	runId, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	w := &writer{ctx: ctx, tx: tx, runId: runId, statements: map[string]*sql.Stmt{}}
+	w.writeTables(response)
+	w.writeWorkbooks(response)
+	w.writeDatasources(response)
+	w.writeSheets(response)
+	w.writeLineage(response)
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return runId, nil
+}
+
+type database interface {
+	GetId() string
+	GetName() string
+	GetConnectionType() string
+	GetDescription() string
+}
+
+type owner interface {
+	GetId() string
+	GetLuid() string
+	GetName() string
+	GetUsername() string
+	GetEmail() string
+}
+
+type tag interface {
+	GetId() string
+	GetName() string
+}
+
+// writer inserts the rows of a single run, it stops at the first error and keeps it in err.
+type writer struct {
+	ctx        context.Context
+	tx         *sql.Tx
+	runId      int64
+	statements map[string]*sql.Stmt
+	err        error
+}
+
+// insert adds a row to table, rows already written by this run, like owners shared by several
+// workbooks, are updated with the values written last. Rows which violate any other constraint fail
+// the whole run.
+func (w *writer) insert(table string, columns []string, values ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	stmt, ok := w.statements[table]
+	if !ok {
+		query := fmt.Sprintf("INSERT INTO %s (run_id, %s) VALUES (?%s) ON CONFLICT (run_id, %s) %s",
+			table, strings.Join(columns, ", "), strings.Repeat(", ?", len(columns)), strings.Join(keys[table], ", "), onConflict(table, columns))
+		if stmt, w.err = w.tx.PrepareContext(w.ctx, query); w.err != nil {
+			return
+		}
+		w.statements[table] = stmt
+	}
+	if _, err := stmt.ExecContext(w.ctx, append([]interface{}{w.runId}, values...)...); err != nil {
+		w.err = fmt.Errorf("failed to write %s: %w", table, err)
+	}
+}
+
+// keys are the primary keys of the tables besides run_id.
+var keys = map[string][]string{
+	"databases":         {"id"},
+	"tables":            {"id"},
+	"columns":           {"id"},
+	"owners":            {"id"},
+	"workbooks":         {"id"},
+	"datasources":       {"id"},
+	"datasource_tables": {"datasource_id", "table_id"},
+	"workbook_tables":   {"workbook_id", "table_id"},
+	"sheets":            {"id"},
+	"sheet_tables":      {"sheet_id", "table_id"},
+	"tags":              {"id"},
+	"content_tags":      {"tag_id", "content_id"},
+	"lineage_edges":     {"source_id", "target_id"},
+}
+
+// onConflict updates the columns of table which are not part of its key.
+func onConflict(table string, columns []string) string {
+	key := map[string]bool{}
+	for _, column := range keys[table] {
+		key[column] = true
+	}
+	var updates []string
+	for _, column := range columns {
+		if !key[column] {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", column, column))
+		}
+	}
+	if len(updates) == 0 {
+		return "DO NOTHING"
+	}
+	return "DO UPDATE SET " + strings.Join(updates, ", ")
+}
+
+var (
+	databaseColumns        = []string{"id", "name", "connection_type", "description"}
+	tableColumns           = []string{"id", "type", "name", "schema", "full_name", "identifier", "database_id", "connection_type", "project_name", "description", "is_embedded", "is_certified", "has_active_warning", "query"}
+	columnColumns          = []string{"id", "table_id", "name", "remote_type"}
+	ownerColumns           = []string{"id", "luid", "name", "username", "email"}
+	workbookColumns        = []string{"id", "luid", "name", "description", "project_name", "uri", "owner_id", "created_at", "updated_at"}
+	datasourceColumns      = []string{"id", "type", "luid", "name", "description", "project_name", "workbook_id", "owner_id", "is_certified", "has_extracts", "created_at", "updated_at"}
+	datasourceTableColumns = []string{"datasource_id", "table_id"}
+	workbookTableColumns   = []string{"workbook_id", "table_id"}
+	sheetTableColumns      = []string{"sheet_id", "table_id"}
+	sheetColumns           = []string{"id", "type", "luid", "name", "path", "workbook_id", "created_at", "updated_at"}
+	tagColumns             = []string{"id", "name"}
+	contentTagColumns      = []string{"tag_id", "content_id", "content_type"}
+	lineageEdgeColumns     = []string{"source_id", "source_type", "source_name", "source_parent_id", "target_id", "target_type", "target_name", "target_parent_id"}
+)
+
+func (w *writer) writeTables(response *model.Response) {
+	for _, table := range response.DatabaseTables {
+		databaseId := w.writeDatabase(table.Database)
+		w.insert("tables", tableColumns, table.Id, table.Typename, table.Name, table.Schema, table.FullName, table.Identifier.Canonical, databaseId, table.ConnectionType, table.ProjectName, table.Description, table.IsEmbedded, table.IsCertified, table.HasActiveWarning, nil)
+		for _, column := range table.Columns {
+			w.insert("columns", columnColumns, column.Id, table.Id, column.Name, string(column.RemoteType))
+		}
+	}
+	for _, table := range response.CustomSQLTables {
+		databaseId := w.writeDatabase(table.Database)
+		w.insert("tables", tableColumns, table.Id, table.Typename, table.Name, "", "", nil, databaseId, table.ConnectionType, "", table.Description, table.IsEmbedded, false, false, table.Query)
+		for _, column := range table.Columns {
+			w.insert("columns", columnColumns, column.Id, table.Id, column.Name, string(column.RemoteType))
+		}
+	}
+}
+
+func (w *writer) writeDatabase(database database) interface{} {
+	if database == nil {
+		return nil
+	}
+	w.insert("databases", databaseColumns, database.GetId(), database.GetName(), database.GetConnectionType(), database.GetDescription())
+	return database.GetId()
+}
+
+func (w *writer) writeOwner(owner owner) interface{} {
+	if owner.GetId() == "" {
+		return nil
+	}
+	w.insert("owners", ownerColumns, owner.GetId(), owner.GetLuid(), owner.GetName(), owner.GetUsername(), owner.GetEmail())
+	return owner.GetId()
+}
+
+func (w *writer) writeTag(tag tag, contentId, contentType string) {
+	w.insert("tags", tagColumns, tag.GetId(), tag.GetName())
+	w.insert("content_tags", contentTagColumns, tag.GetId(), contentId, contentType)
+}
+
+func (w *writer) writeWorkbooks(response *model.Response) {
+	for _, workbook := range response.Workbooks {
+		ownerId := w.writeOwner(&workbook.Owner)
+		w.insert("workbooks", workbookColumns, workbook.Id, workbook.Luid, workbook.Name, workbook.Description, workbook.ProjectName, workbook.Uri, ownerId, timestamp(workbook.CreatedAt), timestamp(workbook.UpdatedAt))
+		for _, table := range workbook.UpstreamTables {
+			w.insert("workbook_tables", workbookTableColumns, workbook.Id, table.Id)
+		}
+		for i := range workbook.Tags {
+			w.writeTag(&workbook.Tags[i], workbook.Id, workbook.Typename)
+		}
+	}
+}
+
+func (w *writer) writeDatasources(response *model.Response) {
+	for _, datasource := range response.PublishedDatasources {
+		ownerId := w.writeOwner(&datasource.Owner)
+		w.insert("datasources", datasourceColumns, datasource.Id, datasource.Typename, datasource.Luid, datasource.Name, datasource.Description, datasource.ProjectName, nil, ownerId, datasource.IsCertified, datasource.HasExtracts, timestamp(datasource.CreatedAt), timestamp(datasource.UpdatedAt))
+		for _, table := range datasource.UpstreamTables {
+			w.insert("datasource_tables", datasourceTableColumns, datasource.Id, table.Id)
+		}
+		for i := range datasource.Tags {
+			w.writeTag(&datasource.Tags[i], datasource.Id, datasource.Typename)
+		}
+	}
+	for _, datasource := range response.EmbeddedDatasources {
+		ownerId := w.writeOwner(&datasource.Workbook.Owner)
+		w.insert("datasources", datasourceColumns, datasource.Id, datasource.Typename, nil, datasource.Name, "", datasource.Workbook.ProjectName, datasource.Workbook.Id, ownerId, false, datasource.HasExtracts, timestamp(datasource.CreatedAt), timestamp(datasource.UpdatedAt))
+		for _, table := range datasource.UpstreamTables {
+			w.insert("datasource_tables", datasourceTableColumns, datasource.Id, table.Id)
+		}
+	}
+}
+
+func (w *writer) writeSheets(response *model.Response) {
+	for _, sheet := range response.Sheets {
+		w.writeOwner(&sheet.Workbook.Owner)
+		w.insert("sheets", sheetColumns, sheet.Id, sheet.Typename, sheet.Luid, sheet.Name, sheet.Path, sheet.Workbook.Id, timestamp(sheet.CreatedAt), timestamp(sheet.UpdatedAt))
+		for _, table := range sheet.UpstreamTables {
+			w.insert("sheet_tables", sheetTableColumns, sheet.Id, table.GetId())
+		}
+		for i := range sheet.Tags {
+			w.writeTag(&sheet.Tags[i], sheet.Id, sheet.Typename)
+		}
+	}
+	for _, dashboard := range response.Dashboards {
+		w.writeOwner(&dashboard.Workbook.Owner)
+		w.insert("sheets", sheetColumns, dashboard.Id, dashboard.Typename, dashboard.Luid, dashboard.Name, dashboard.Path, dashboard.Workbook.Id, timestamp(dashboard.CreatedAt), timestamp(dashboard.UpdatedAt))
+		for _, table := range dashboard.UpstreamTables {
+			w.insert("sheet_tables", sheetTableColumns, dashboard.Id, table.GetId())
+		}
+		for i := range dashboard.Tags {
+			w.writeTag(&dashboard.Tags[i], dashboard.Id, dashboard.Typename)
+		}
+	}
+}
+
+func (w *writer) writeLineage(response *model.Response) {
+	for _, edge := range response.ColumnLineage {
+		w.insert("lineage_edges", lineageEdgeColumns,
+			edge.Source.Id, edge.Source.Type, edge.Source.Name, nullable(edge.Source.ParentId),
+			edge.Target.Id, edge.Target.Type, edge.Target.Name, nullable(edge.Target.ParentId))
+	}
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
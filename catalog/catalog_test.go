@@ -0,0 +1,113 @@
+package catalog
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/getsynq/connections-tableau/lineage"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+)
+
+func TestWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.db")
+	database := &metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer{Id: "d1", Name: "db", ConnectionType: "snowflake"}
+	response := &model.Response{
+		ExtractedAt: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		DatabaseTables: model.DatabaseTables(
+			&metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{Id: "t1", Typename: "DatabaseTable", Name: "orders", FullName: "public.orders", ConnectionType: "snowflake", Database: database, Columns: []metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn{{Id: "c1", Name: "id", RemoteType: "I8"}}},
+			&metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{Id: "t2", Typename: "DatabaseTable", Name: "customers", Database: database},
+		),
+		Workbooks: []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook{
+			{Id: "w1", Typename: "Workbook", Name: "Sales", Owner: metadata.GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser{Id: "u1", Name: "Jo"}, Tags: []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag{{Id: "g1", Name: "finance"}}, UpstreamTables: []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable{{Id: "t1"}}},
+			// the owner shared with the first workbook is written once, with the values seen last
+			{Id: "w2", Typename: "Workbook", Name: "Orders", Owner: metadata.GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser{Id: "u1", Name: "Jo Doe"}},
+		},
+		Dashboards: []metadata.GetDashboardsDashboardsConnectionNodesDashboard{{
+			Id: "d1", Typename: "Dashboard", Name: "Overview", Workbook: metadata.GetDashboardsDashboardsConnectionNodesDashboardWorkbook{Id: "w1"},
+			UpstreamTables: []metadata.GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable{
+				&metadata.GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable{Id: "t1"},
+				&metadata.GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable{Id: "t2"},
+			},
+		}},
+		ColumnLineage: []lineage.Edge{
+			{Source: lineage.Node{Id: "c1", Type: "Column", Name: "id", ParentId: "t1"}, Target: lineage.Node{Id: "s1", Type: "Sheet", Name: "Revenue"}},
+		},
+	}
+
+	for run := int64(1); run <= 2; run++ {
+		c, err := Open(path)
+		if err != nil {
+			t.Fatalf("Open() error = %v", err)
+		}
+		got, err := c.Write(context.Background(), response, "https://tableau.example.com", "site")
+		if err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		if got != run {
+			t.Errorf("Write() run ID = %d, want %d", got, run)
+		}
+		c.Close()
+	}
+
+	c, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer c.Close()
+
+	counts := map[string]int{
+		"SELECT count(*) FROM runs":                                         2,
+		"SELECT count(*) FROM databases WHERE run_id = 2":                   1,
+		"SELECT count(*) FROM tables WHERE run_id = 2":                      2,
+		"SELECT count(*) FROM owners WHERE run_id = 2":                      1,
+		"SELECT count(*) FROM owners WHERE name = 'Jo Doe'":                 2,
+		"SELECT count(*) FROM content_tags WHERE run_id = 2":                1,
+		"SELECT count(*) FROM lineage_edges WHERE run_id = 2":               1,
+		"SELECT count(*) FROM workbooks WHERE owner_id = 'u1'":              4,
+		"SELECT count(*) FROM tables WHERE database_id IS NULL":             0,
+		"SELECT count(*) FROM tables WHERE identifier = 'DB.PUBLIC.ORDERS'": 2,
+		"SELECT count(*) FROM workbook_tables WHERE run_id = 2":             1,
+		"SELECT count(*) FROM sheet_tables WHERE run_id = 2":                2,
+	}
+	for query, want := range counts {
+		var got int
+		if err := c.db.QueryRow(query).Scan(&got); err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		if got != want {
+			t.Errorf("%s = %d, want %d", query, got, want)
+		}
+	}
+
+	var table, sheet, extractedAt string
+	err = c.db.QueryRow(`
+		SELECT t.name, e.target_name, r.extracted_at
+		FROM lineage_edges e
+		JOIN columns c ON c.run_id = e.run_id AND c.id = e.source_id
+		JOIN tables t ON t.run_id = c.run_id AND t.id = c.table_id
+		JOIN runs r ON r.run_id = e.run_id
+		WHERE e.run_id = 1`).Scan(&table, &sheet, &extractedAt)
+	if err != nil {
+		t.Fatalf("lineage query: %v", err)
+	}
+	if table != "orders" || sheet != "Revenue" || extractedAt != "2023-01-02T03:04:05Z" {
+		t.Errorf("lineage query = %s, %s, %s", table, sheet, extractedAt)
+	}
+
+	var dashboard string
+	err = c.db.QueryRow(`
+		SELECT s.name
+		FROM tables t
+		JOIN sheet_tables st ON st.run_id = t.run_id AND st.table_id = t.id
+		JOIN sheets s ON s.run_id = st.run_id AND s.id = st.sheet_id
+		WHERE t.run_id = 2 AND t.identifier = 'DB.PUBLIC.ORDERS' AND s.type = 'Dashboard'`).Scan(&dashboard)
+	if err != nil {
+		t.Fatalf("dashboard query: %v", err)
+	}
+	if dashboard != "Overview" {
+		t.Errorf("dashboard query = %s, want Overview", dashboard)
+	}
+}
@@ -0,0 +1,158 @@
+package catalog
+
+// schema creates the catalog tables, every row belongs to the run it was written by so snapshots of
+// several runs can be compared side by side.
+const schema = `
+CREATE TABLE IF NOT EXISTS runs (
+	run_id       INTEGER PRIMARY KEY AUTOINCREMENT,
+	extracted_at TEXT NOT NULL,
+	url          TEXT NOT NULL,
+	site         TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS databases (
+	run_id          INTEGER NOT NULL REFERENCES runs (run_id),
+	id              TEXT NOT NULL,
+	name            TEXT NOT NULL,
+	connection_type TEXT NOT NULL,
+	description     TEXT NOT NULL,
+	PRIMARY KEY (run_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS tables (
+	run_id             INTEGER NOT NULL REFERENCES runs (run_id),
+	id                 TEXT NOT NULL,
+	type               TEXT NOT NULL,
+	name               TEXT NOT NULL,
+	schema             TEXT NOT NULL,
+	full_name          TEXT NOT NULL,
+	identifier         TEXT,
+	database_id        TEXT,
+	connection_type    TEXT NOT NULL,
+	project_name       TEXT NOT NULL,
+	description        TEXT NOT NULL,
+	is_embedded        BOOLEAN NOT NULL,
+	is_certified       BOOLEAN NOT NULL,
+	has_active_warning BOOLEAN NOT NULL,
+	query              TEXT,
+	PRIMARY KEY (run_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS columns (
+	run_id      INTEGER NOT NULL REFERENCES runs (run_id),
+	id          TEXT NOT NULL,
+	table_id    TEXT NOT NULL,
+	name        TEXT NOT NULL,
+	remote_type TEXT NOT NULL,
+	PRIMARY KEY (run_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS owners (
+	run_id   INTEGER NOT NULL REFERENCES runs (run_id),
+	id       TEXT NOT NULL,
+	luid     TEXT NOT NULL,
+	name     TEXT NOT NULL,
+	username TEXT NOT NULL,
+	email    TEXT NOT NULL,
+	PRIMARY KEY (run_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS workbooks (
+	run_id       INTEGER NOT NULL REFERENCES runs (run_id),
+	id           TEXT NOT NULL,
+	luid         TEXT NOT NULL,
+	name         TEXT NOT NULL,
+	description  TEXT NOT NULL,
+	project_name TEXT NOT NULL,
+	uri          TEXT NOT NULL,
+	owner_id     TEXT,
+	created_at   TEXT NOT NULL,
+	updated_at   TEXT NOT NULL,
+	PRIMARY KEY (run_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS datasources (
+	run_id       INTEGER NOT NULL REFERENCES runs (run_id),
+	id           TEXT NOT NULL,
+	type         TEXT NOT NULL,
+	luid         TEXT,
+	name         TEXT NOT NULL,
+	description  TEXT NOT NULL,
+	project_name TEXT NOT NULL,
+	workbook_id  TEXT,
+	owner_id     TEXT,
+	is_certified BOOLEAN NOT NULL,
+	has_extracts BOOLEAN NOT NULL,
+	created_at   TEXT NOT NULL,
+	updated_at   TEXT NOT NULL,
+	PRIMARY KEY (run_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS datasource_tables (
+	run_id        INTEGER NOT NULL REFERENCES runs (run_id),
+	datasource_id TEXT NOT NULL,
+	table_id      TEXT NOT NULL,
+	PRIMARY KEY (run_id, datasource_id, table_id)
+);
+
+CREATE TABLE IF NOT EXISTS workbook_tables (
+	run_id      INTEGER NOT NULL REFERENCES runs (run_id),
+	workbook_id TEXT NOT NULL,
+	table_id    TEXT NOT NULL,
+	PRIMARY KEY (run_id, workbook_id, table_id)
+);
+
+CREATE TABLE IF NOT EXISTS sheets (
+	run_id      INTEGER NOT NULL REFERENCES runs (run_id),
+	id          TEXT NOT NULL,
+	type        TEXT NOT NULL,
+	luid        TEXT NOT NULL,
+	name        TEXT NOT NULL,
+	path        TEXT NOT NULL,
+	workbook_id TEXT NOT NULL,
+	created_at  TEXT NOT NULL,
+	updated_at  TEXT NOT NULL,
+	PRIMARY KEY (run_id, id)
+);
+
+-- sheet_tables holds the upstream tables of sheets and dashboards, which are both in sheets
+CREATE TABLE IF NOT EXISTS sheet_tables (
+	run_id   INTEGER NOT NULL REFERENCES runs (run_id),
+	sheet_id TEXT NOT NULL,
+	table_id TEXT NOT NULL,
+	PRIMARY KEY (run_id, sheet_id, table_id)
+);
+
+CREATE TABLE IF NOT EXISTS tags (
+	run_id INTEGER NOT NULL REFERENCES runs (run_id),
+	id     TEXT NOT NULL,
+	name   TEXT NOT NULL,
+	PRIMARY KEY (run_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS content_tags (
+	run_id       INTEGER NOT NULL REFERENCES runs (run_id),
+	tag_id       TEXT NOT NULL,
+	content_id   TEXT NOT NULL,
+	content_type TEXT NOT NULL,
+	PRIMARY KEY (run_id, tag_id, content_id)
+);
+
+CREATE TABLE IF NOT EXISTS lineage_edges (
+	run_id           INTEGER NOT NULL REFERENCES runs (run_id),
+	source_id        TEXT NOT NULL,
+	source_type      TEXT NOT NULL,
+	source_name      TEXT NOT NULL,
+	source_parent_id TEXT,
+	target_id        TEXT NOT NULL,
+	target_type      TEXT NOT NULL,
+	target_name      TEXT NOT NULL,
+	target_parent_id TEXT,
+	PRIMARY KEY (run_id, source_id, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS columns_table ON columns (run_id, table_id);
+CREATE INDEX IF NOT EXISTS lineage_edges_target ON lineage_edges (run_id, target_id);
+CREATE INDEX IF NOT EXISTS workbook_tables_table ON workbook_tables (run_id, table_id);
+CREATE INDEX IF NOT EXISTS sheet_tables_table ON sheet_tables (run_id, table_id);
+`
@@ -0,0 +1,599 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/getsynq/connections-tableau/filter"
+	"github.com/getsynq/connections-tableau/internal"
+	"github.com/getsynq/connections-tableau/lineage"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/getsynq/connections-tableau/output"
+	"github.com/getsynq/connections-tableau/sqlparse"
+	"github.com/pkg/errors"
+)
+
+// crawl downloads everything matching filters, recording its progress in checkpoint unless it is nil.
+// With a writer the accepted nodes are passed to it page by page as they are downloaded and then
+// dropped, only what is not streamed, like the lineage built from all pages, is left in the export.
+// Without one the accepted nodes are collected into the export.
+func crawl(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, filters *filter.Options, writer output.Writer, progress io.Writer) (*model.Response, error) {
+	response := &model.Response{
+		ExtractedAt:          checkpoint.Started(),
+		DatabaseTables:       make([]*model.DatabaseTable, 0),
+		PublishedDatasources: make([]metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource, 0),
+		EmbeddedDatasources:  make([]metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource, 0),
+		Workbooks:            make([]metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook, 0),
+		Sheets:               make([]metadata.GetSheetsSheetsConnectionNodesSheet, 0),
+		Dashboards:           make([]metadata.GetDashboardsDashboardsConnectionNodesDashboard, 0),
+		Flows:                make([]metadata.GetFlowsFlowsConnectionNodesFlow, 0),
+	}
+	// only the ids of what lineage and the sheets and dashboards are requested for are kept across pages
+	scoped := !filters.AcceptsAll()
+	var columnIds, calculatedFieldIds, sheetIds, workbookSheetIds, workbookDashboardIds []string
+
+	databaseTables := 0
+	_, err := fetchDatabaseTables(ctx, client, perPage, checkpoint, filters.DatabaseTableFilter(), func(page []metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) error {
+		accepted := acceptDatabaseTables(page, filters)
+		databaseTables += len(accepted)
+		if scoped {
+			for _, table := range accepted {
+				for _, column := range table.Columns {
+					columnIds = append(columnIds, column.Id)
+				}
+			}
+		}
+		return keep(writer, "databaseTables", &response.DatabaseTables, accepted)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain metadata")
+	}
+
+	fmt.Fprintf(progress, "Discovered %d database tables\n", databaseTables)
+
+	tableCounts, err := fetchDatabaseTableCounts(ctx, client, perPage, checkpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count database tables")
+	}
+	skippedDatabaseTables := map[string]int{}
+	for connectionType, count := range tableCounts {
+		if !filters.ConnectionTypes.Accepts(connectionType) {
+			skippedDatabaseTables[connectionType] = count
+		}
+	}
+	printSkipped(progress, "database tables", skippedDatabaseTables)
+
+	customSQLTables, customSQLReferences, customSQLColumnIds, err := crawlCustomSQLTables(ctx, client, perPage, checkpoint, filters, writer, progress)
+	if err != nil {
+		return nil, err
+	}
+	response.CustomSQLTables, response.CustomSQLReferences = customSQLTables, customSQLReferences
+	if scoped {
+		columnIds = append(columnIds, customSQLColumnIds...)
+	}
+
+	publishedDatasources := 0
+	_, err = fetchPublishedDatasources(ctx, client, perPage, checkpoint, filters.PublishedDatasourceFilter(), func(page []metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) error {
+		accepted := acceptPublishedDatasources(page, filters)
+		publishedDatasources += len(accepted)
+		if scoped {
+			for _, datasource := range accepted {
+				calculatedFieldIds = append(calculatedFieldIds, calculatedFields(datasource.Fields)...)
+			}
+		}
+		return keep(writer, "publishedDatasources", &response.PublishedDatasources, accepted)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain published datasources")
+	}
+
+	fmt.Fprintf(progress, "Discovered %d published datasources\n", publishedDatasources)
+
+	embeddedDatasources := 0
+	_, err = fetchEmbeddedDatasources(ctx, client, perPage, checkpoint, filters.EmbeddedDatasourceFilter(), func(page []metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) error {
+		accepted := acceptEmbeddedDatasources(page, filters)
+		embeddedDatasources += len(accepted)
+		if scoped {
+			for _, datasource := range accepted {
+				calculatedFieldIds = append(calculatedFieldIds, calculatedFields(datasource.Fields)...)
+			}
+		}
+		return keep(writer, "embeddedDatasources", &response.EmbeddedDatasources, accepted)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain embedded datasources")
+	}
+
+	fmt.Fprintf(progress, "Discovered %d embedded datasources\n", embeddedDatasources)
+
+	workbooks := 0
+	_, err = fetchWorkbooks(ctx, client, perPage, checkpoint, filters.WorkbookFilter(), func(page []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook) error {
+		accepted := acceptWorkbooks(page, filters)
+		workbooks += len(accepted)
+		if filters.ScopesWorkbooks() {
+			for _, workbook := range accepted {
+				for _, sheet := range workbook.Sheets {
+					workbookSheetIds = append(workbookSheetIds, sheet.Id)
+				}
+				for _, dashboard := range workbook.Dashboards {
+					workbookDashboardIds = append(workbookDashboardIds, dashboard.Id)
+				}
+			}
+		}
+		return keep(writer, "workbooks", &response.Workbooks, accepted)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain workbooks")
+	}
+
+	fmt.Fprintf(progress, "Discovered %d workbooks\n", workbooks)
+
+	// sheets and dashboards cannot be filtered by workbook, with a project or workbook filter those of
+	// the accepted workbooks are requested by id and still matched client side, as a workbook may have
+	// been renamed or moved in between
+	sheets := 0
+	sheetPage := func(page []metadata.GetSheetsSheetsConnectionNodesSheet) error {
+		accepted := acceptSheets(page, filters)
+		sheets += len(accepted)
+		if scoped {
+			for _, sheet := range accepted {
+				sheetIds = append(sheetIds, sheet.Id)
+			}
+		}
+		return keep(writer, "sheets", &response.Sheets, accepted)
+	}
+	if filters.ScopesWorkbooks() {
+		_, err = fetchByIds(workbookSheetIds, checkpoint, "sheets", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetSheetsSheetsConnectionNodesSheet, error) {
+			return fetchSheets(ctx, client, perPage, checkpoint, &filter.Sheet{IdWithin: ids}, sheetPage)
+		})
+	} else {
+		_, err = fetchSheets(ctx, client, perPage, checkpoint, nil, sheetPage)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain sheets")
+	}
+
+	fmt.Fprintf(progress, "Discovered %d sheets\n", sheets)
+
+	dashboards := 0
+	dashboardPage := func(page []metadata.GetDashboardsDashboardsConnectionNodesDashboard) error {
+		accepted := acceptDashboards(page, filters)
+		dashboards += len(accepted)
+		return keep(writer, "dashboards", &response.Dashboards, accepted)
+	}
+	if filters.ScopesWorkbooks() {
+		_, err = fetchByIds(workbookDashboardIds, checkpoint, "dashboards", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetDashboardsDashboardsConnectionNodesDashboard, error) {
+			return fetchDashboards(ctx, client, perPage, checkpoint, &filter.Dashboard{IdWithin: ids}, dashboardPage)
+		})
+	} else {
+		_, err = fetchDashboards(ctx, client, perPage, checkpoint, nil, dashboardPage)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain dashboards")
+	}
+
+	fmt.Fprintf(progress, "Discovered %d dashboards\n", dashboards)
+
+	flows := 0
+	_, err = fetchFlows(ctx, client, perPage, checkpoint, filters.FlowFilter(), func(page []metadata.GetFlowsFlowsConnectionNodesFlow) error {
+		accepted := acceptFlows(page, filters)
+		flows += len(accepted)
+		return keep(writer, "flows", &response.Flows, accepted)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain flows")
+	}
+
+	fmt.Fprintf(progress, "Discovered %d flows\n", flows)
+
+	// lineage is requested for what was accepted above, without any filters everything is accepted and
+	// it is requested site-wide instead of by id
+	builder := lineage.NewBuilder()
+	if scoped {
+		err = crawlLineage(ctx, client, perPage, checkpoint, columnIds, calculatedFieldIds, sheetIds, builder)
+	} else {
+		err = crawlSiteLineage(ctx, client, perPage, checkpoint, builder)
+	}
+	if err != nil {
+		return nil, err
+	}
+	response.ColumnLineage = builder.Edges()
+
+	fmt.Fprintf(progress, "Discovered %d column lineage edges\n", len(response.ColumnLineage))
+
+	return response, nil
+}
+
+// crawlSiteLineage downloads the lineage of every column, calculated field and sheet of the site.
+func crawlSiteLineage(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, builder *lineage.Builder) error {
+	if _, err := fetchColumnLineage(ctx, client, perPage, checkpoint, nil, pageTo(builder.Columns)); err != nil {
+		return errors.Wrap(err, "failed to obtain column lineage")
+	}
+	if _, err := fetchCalculatedFieldLineage(ctx, client, perPage, checkpoint, nil, pageTo(builder.CalculatedFields)); err != nil {
+		return errors.Wrap(err, "failed to obtain calculated field lineage")
+	}
+	if _, err := fetchSheetFieldLineage(ctx, client, perPage, checkpoint, nil, pageTo(builder.Sheets)); err != nil {
+		return errors.Wrap(err, "failed to obtain sheet field lineage")
+	}
+	return nil
+}
+
+// crawlLineage downloads the lineage of the columns, calculated fields and sheets with the given ids.
+func crawlLineage(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, columnIds, calculatedFieldIds, sheetIds []string, builder *lineage.Builder) error {
+	_, err := fetchByIds(columnIds, checkpoint, "columnLineage", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetColumnLineageColumnsConnectionNodesColumn, error) {
+		return fetchColumnLineage(ctx, client, perPage, checkpoint, &filter.Column{IdWithin: ids}, pageTo(builder.Columns))
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain column lineage")
+	}
+	_, err = fetchByIds(calculatedFieldIds, checkpoint, "calculatedFieldLineage", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField, error) {
+		return fetchCalculatedFieldLineage(ctx, client, perPage, checkpoint, &filter.CalculatedField{IdWithin: ids}, pageTo(builder.CalculatedFields))
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain calculated field lineage")
+	}
+	_, err = fetchByIds(sheetIds, checkpoint, "sheetFieldLineage", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetSheetFieldLineageSheetsConnectionNodesSheet, error) {
+		return fetchSheetFieldLineage(ctx, client, perPage, checkpoint, &filter.Sheet{IdWithin: ids}, pageTo(builder.Sheets))
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain sheet field lineage")
+	}
+	return nil
+}
+
+// crawlCustomSQLTables downloads all custom SQL tables and parses their queries page by page, they have
+// no timestamps so incremental crawls download them in full as well. It returns the accepted tables
+// and their references unless they were passed to writer, and the ids of their columns.
+func crawlCustomSQLTables(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, filters *filter.Options, writer output.Writer, progress io.Writer) ([]*metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable, []*model.CustomSQLReferences, []string, error) {
+	customSQLTables := make([]*metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable, 0)
+	customSQLReferences := make([]*model.CustomSQLReferences, 0)
+	var columnIds []string
+	accepted, unparsed := 0, 0
+	skippedCustomSQLTables := map[string]int{}
+	_, err := fetchCustomSQLTables(ctx, client, perPage, checkpoint, nil, func(page []metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) error {
+		for _, customSQLTable := range page {
+			if !filters.ConnectionTypes.Accepts(customSQLTable.ConnectionType) {
+				skippedCustomSQLTables[customSQLTable.ConnectionType] += 1
+			}
+		}
+		tables := acceptCustomSQLTables(page, filters)
+		accepted += len(tables)
+		references := make([]*model.CustomSQLReferences, 0, len(tables))
+		for _, customSQLTable := range tables {
+			for _, column := range customSQLTable.Columns {
+				columnIds = append(columnIds, column.Id)
+			}
+			// the Metadata API has no default schema of a connection, references without a schema
+			// are left unqualified rather than guessed
+			opts := sqlparse.Options{DefaultDatabase: databaseName(customSQLTable.Database)}
+			result := sqlparse.Parse(customSQLTable.Query, sqlparse.DialectForConnectionType(customSQLTable.ConnectionType), opts)
+			if !result.Confident {
+				unparsed += 1
+			}
+			references = append(references, &model.CustomSQLReferences{
+				CustomSQLTableId: customSQLTable.Id,
+				Result:           result,
+			})
+		}
+		if err := keep(writer, "customSQLTables", &customSQLTables, tables); err != nil {
+			return err
+		}
+		return keep(writer, "customSQLReferences", &customSQLReferences, references)
+	})
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to obtain custom SQL metadata")
+	}
+
+	fmt.Fprintf(progress, "Discovered %d custom SQL tables\n", accepted)
+	printSkipped(progress, "custom SQL tables", skippedCustomSQLTables)
+
+	if unparsed > 0 {
+		fmt.Fprintf(progress, "Could not fully parse %d custom SQL queries\n", unparsed)
+	}
+
+	return customSQLTables, customSQLReferences, columnIds, nil
+}
+
+func acceptDatabaseTables(nodes []metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable, filters *filter.Options) []*model.DatabaseTable {
+	databaseTables := make([]*model.DatabaseTable, 0)
+	for _, databaseTable := range nodes {
+		databaseTable := databaseTable
+		if filters.AcceptsDatabaseTable(databaseTable.ConnectionType, databaseTable.ProjectName, databaseName(databaseTable.Database), databaseTable.Schema, databaseTable.Name) {
+			databaseTables = append(databaseTables, model.NewDatabaseTable(&databaseTable))
+		}
+	}
+	return databaseTables
+}
+
+func acceptCustomSQLTables(nodes []metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable, filters *filter.Options) []*metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable {
+	customSQLTables := make([]*metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable, 0)
+	for _, customSQLTable := range nodes {
+		customSQLTable := customSQLTable
+		if filters.ConnectionTypes.Accepts(customSQLTable.ConnectionType) && filters.AcceptsCustomSQLTable(customSQLTable.ConnectionType, databaseName(customSQLTable.Database)) {
+			customSQLTables = append(customSQLTables, &customSQLTable)
+		}
+	}
+	return customSQLTables
+}
+
+func acceptPublishedDatasources(nodes []metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource, filters *filter.Options) []metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource {
+	return filterNodes(nodes, func(datasource *metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) bool {
+		return filters.AcceptsDatasource(datasource.ProjectName, datasource.Name)
+	})
+}
+
+func acceptEmbeddedDatasources(nodes []metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource, filters *filter.Options) []metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource {
+	return filterNodes(nodes, func(datasource *metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) bool {
+		return filters.AcceptsDatasource(datasource.Workbook.ProjectName, datasource.Name) && filters.AcceptsWorkbook(datasource.Workbook.ProjectName, datasource.Workbook.Name)
+	})
+}
+
+func acceptWorkbooks(nodes []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook, filters *filter.Options) []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook {
+	return filterNodes(nodes, func(workbook *metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook) bool {
+		return filters.AcceptsWorkbook(workbook.ProjectName, workbook.Name)
+	})
+}
+
+func acceptSheets(nodes []metadata.GetSheetsSheetsConnectionNodesSheet, filters *filter.Options) []metadata.GetSheetsSheetsConnectionNodesSheet {
+	return filterNodes(nodes, func(sheet *metadata.GetSheetsSheetsConnectionNodesSheet) bool {
+		return filters.AcceptsWorkbook(sheet.Workbook.ProjectName, sheet.Workbook.Name)
+	})
+}
+
+func acceptDashboards(nodes []metadata.GetDashboardsDashboardsConnectionNodesDashboard, filters *filter.Options) []metadata.GetDashboardsDashboardsConnectionNodesDashboard {
+	return filterNodes(nodes, func(dashboard *metadata.GetDashboardsDashboardsConnectionNodesDashboard) bool {
+		return filters.AcceptsWorkbook(dashboard.Workbook.ProjectName, dashboard.Workbook.Name)
+	})
+}
+
+func acceptFlows(nodes []metadata.GetFlowsFlowsConnectionNodesFlow, filters *filter.Options) []metadata.GetFlowsFlowsConnectionNodesFlow {
+	return filterNodes(nodes, func(flow *metadata.GetFlowsFlowsConnectionNodesFlow) bool {
+		return filters.AcceptsFlow(flow.ProjectName)
+	})
+}
+
+// keep passes the accepted nodes of a page to writer, without a writer they are appended to nodes.
+func keep[T any](writer output.Writer, entity string, nodes *[]T, page []T) error {
+	if writer == nil {
+		*nodes = append(*nodes, page...)
+		return nil
+	}
+	return writer.WritePage(entity, page)
+}
+
+// flush passes nodes to writer and returns nil so that they can be dropped, without a writer it
+// returns them to be kept in the export.
+func flush[T any](writer output.Writer, entity string, nodes []T) ([]T, error) {
+	if writer == nil {
+		return nodes, nil
+	}
+	return nil, writer.WritePage(entity, nodes)
+}
+
+// pageTo adapts add to a page callback.
+func pageTo[T any](add func(page []T)) func(page []T) error {
+	return func(page []T) error {
+		add(page)
+		return nil
+	}
+}
+
+// calculatedFields returns the ids of the calculated fields among the fields of a datasource.
+func calculatedFields[T interface {
+	GetTypename() string
+	GetId() string
+}](fields []T) []string {
+	var ids []string
+	for _, field := range fields {
+		if any(field) != nil && field.GetTypename() == "CalculatedField" {
+			ids = append(ids, field.GetId())
+		}
+	}
+	return ids
+}
+
+func filterNodes[T any](nodes []T, accept func(node *T) bool) []T {
+	accepted := make([]T, 0, len(nodes))
+	for i := range nodes {
+		if accept(&nodes[i]) {
+			accepted = append(accepted, nodes[i])
+		}
+	}
+	return accepted
+}
+
+func databaseName(database interface{ GetName() string }) string {
+	if database == nil {
+		return ""
+	}
+	return database.GetName()
+}
+
+func printSkipped(progress io.Writer, entity string, skipped map[string]int) {
+	connectionTypes := make([]string, 0, len(skipped))
+	for connectionType := range skipped {
+		connectionTypes = append(connectionTypes, connectionType)
+	}
+	sort.Strings(connectionTypes)
+	for _, connectionType := range connectionTypes {
+		if skipped[connectionType] > 0 {
+			fmt.Fprintf(progress, "Skipped %d %s with connection type %s\n", skipped[connectionType], entity, connectionType)
+		}
+	}
+}
+
+func fetchDatabaseTables(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, databaseTableFilter *filter.DatabaseTable, onPage func(page []metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) error) ([]metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "databaseTables", func(ctx context.Context, first int, after *string) ([]metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable, internal.PageInfo, error) {
+		resp, err := metadata.GetDatabaseTablesDefinitions(ctx, client, first, after, databaseTableFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.DatabaseTablesConnection.Nodes, &resp.DatabaseTablesConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchCustomSQLTables(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, customSQLTableFilter *filter.CustomSQLTable, onPage func(page []metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) error) ([]metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "customSQLTables", func(ctx context.Context, first int, after *string) ([]metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable, internal.PageInfo, error) {
+		resp, err := metadata.GetCustomSQLTablesDefinitions(ctx, client, first, after, customSQLTableFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.CustomSQLTablesConnection.Nodes, &resp.CustomSQLTablesConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchWorkbooks(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, workbookFilter *filter.Workbook, onPage func(page []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook) error) ([]metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "workbooks", func(ctx context.Context, first int, after *string) ([]metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook, internal.PageInfo, error) {
+		resp, err := metadata.GetWorkbooks(ctx, client, first, after, workbookFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.WorkbooksConnection.Nodes, &resp.WorkbooksConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchSheets(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, sheetFilter *filter.Sheet, onPage func(page []metadata.GetSheetsSheetsConnectionNodesSheet) error) ([]metadata.GetSheetsSheetsConnectionNodesSheet, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "sheets", func(ctx context.Context, first int, after *string) ([]metadata.GetSheetsSheetsConnectionNodesSheet, internal.PageInfo, error) {
+		resp, err := metadata.GetSheets(ctx, client, first, after, sheetFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.SheetsConnection.Nodes, &resp.SheetsConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchDashboards(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, dashboardFilter *filter.Dashboard, onPage func(page []metadata.GetDashboardsDashboardsConnectionNodesDashboard) error) ([]metadata.GetDashboardsDashboardsConnectionNodesDashboard, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "dashboards", func(ctx context.Context, first int, after *string) ([]metadata.GetDashboardsDashboardsConnectionNodesDashboard, internal.PageInfo, error) {
+		resp, err := metadata.GetDashboards(ctx, client, first, after, dashboardFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.DashboardsConnection.Nodes, &resp.DashboardsConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchFlows(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, flowFilter *filter.Flow, onPage func(page []metadata.GetFlowsFlowsConnectionNodesFlow) error) ([]metadata.GetFlowsFlowsConnectionNodesFlow, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "flows", func(ctx context.Context, first int, after *string) ([]metadata.GetFlowsFlowsConnectionNodesFlow, internal.PageInfo, error) {
+		resp, err := metadata.GetFlows(ctx, client, first, after, flowFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.FlowsConnection.Nodes, &resp.FlowsConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchPublishedDatasources(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, publishedDatasourceFilter *filter.PublishedDatasource, onPage func(page []metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) error) ([]metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "publishedDatasources", func(ctx context.Context, first int, after *string) ([]metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource, internal.PageInfo, error) {
+		resp, err := metadata.GetPublishedDatasources(ctx, client, first, after, publishedDatasourceFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.PublishedDatasourcesConnection.Nodes, &resp.PublishedDatasourcesConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchEmbeddedDatasources(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, embeddedDatasourceFilter *filter.EmbeddedDatasource, onPage func(page []metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) error) ([]metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "embeddedDatasources", func(ctx context.Context, first int, after *string) ([]metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource, internal.PageInfo, error) {
+		resp, err := metadata.GetEmbeddedDatasources(ctx, client, first, after, embeddedDatasourceFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.EmbeddedDatasourcesConnection.Nodes, &resp.EmbeddedDatasourcesConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchColumnLineage(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, columnFilter *filter.Column, onPage func(page []metadata.GetColumnLineageColumnsConnectionNodesColumn) error) ([]metadata.GetColumnLineageColumnsConnectionNodesColumn, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "columnLineage", func(ctx context.Context, first int, after *string) ([]metadata.GetColumnLineageColumnsConnectionNodesColumn, internal.PageInfo, error) {
+		resp, err := metadata.GetColumnLineage(ctx, client, first, after, columnFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.ColumnsConnection.Nodes, &resp.ColumnsConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchCalculatedFieldLineage(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, calculatedFieldFilter *filter.CalculatedField, onPage func(page []metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) error) ([]metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "calculatedFieldLineage", func(ctx context.Context, first int, after *string) ([]metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField, internal.PageInfo, error) {
+		resp, err := metadata.GetCalculatedFieldLineage(ctx, client, first, after, calculatedFieldFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.CalculatedFieldsConnection.Nodes, &resp.CalculatedFieldsConnection.PageInfo, nil
+	}, onPage)
+}
+
+func fetchSheetFieldLineage(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, sheetFilter *filter.Sheet, onPage func(page []metadata.GetSheetFieldLineageSheetsConnectionNodesSheet) error) ([]metadata.GetSheetFieldLineageSheetsConnectionNodesSheet, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "sheetFieldLineage", func(ctx context.Context, first int, after *string) ([]metadata.GetSheetFieldLineageSheetsConnectionNodesSheet, internal.PageInfo, error) {
+		resp, err := metadata.GetSheetFieldLineage(ctx, client, first, after, sheetFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.SheetsConnection.Nodes, &resp.SheetsConnection.PageInfo, nil
+	}, onPage)
+}
+
+// databaseTableCount is the number of tables of a database, its nodes are interfaces which do not
+// unmarshal from JSON and are checkpointed in this form instead.
+type databaseTableCount struct {
+	ConnectionType string `json:"connectionType"`
+	Tables         int    `json:"tables"`
+}
+
+// fetchDatabaseTableCounts counts the tables of every connection type.
+func fetchDatabaseTableCounts(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint) (map[string]int, error) {
+	databases, err := internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "databaseTableCounts", func(ctx context.Context, first int, after *string) ([]databaseTableCount, internal.PageInfo, error) {
+		resp, err := metadata.GetDatabaseTableCounts(ctx, client, first, after)
+		if err != nil {
+			return nil, nil, err
+		}
+		counts := make([]databaseTableCount, 0, len(resp.DatabasesConnection.Nodes))
+		for _, database := range resp.DatabasesConnection.Nodes {
+			if database != nil {
+				counts = append(counts, databaseTableCount{ConnectionType: database.GetConnectionType(), Tables: database.GetTablesConnection().TotalCount})
+			}
+		}
+		return counts, &resp.DatabasesConnection.PageInfo, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	counts := map[string]int{}
+	for _, database := range databases {
+		counts[database.ConnectionType] += database.Tables
+	}
+	return counts, nil
+}
+
+func fetchWorkbookVersions(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, workbookFilter *filter.Workbook) ([]metadata.GetWorkbookVersionsWorkbooksConnectionNodesWorkbook, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "workbookVersions", func(ctx context.Context, first int, after *string) ([]metadata.GetWorkbookVersionsWorkbooksConnectionNodesWorkbook, internal.PageInfo, error) {
+		resp, err := metadata.GetWorkbookVersions(ctx, client, first, after, workbookFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.WorkbooksConnection.Nodes, &resp.WorkbooksConnection.PageInfo, nil
+	}, nil)
+}
+
+func fetchPublishedDatasourceVersions(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, publishedDatasourceFilter *filter.PublishedDatasource) ([]metadata.GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "publishedDatasourceVersions", func(ctx context.Context, first int, after *string) ([]metadata.GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource, internal.PageInfo, error) {
+		resp, err := metadata.GetPublishedDatasourceVersions(ctx, client, first, after, publishedDatasourceFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.PublishedDatasourcesConnection.Nodes, &resp.PublishedDatasourcesConnection.PageInfo, nil
+	}, nil)
+}
+
+func fetchFlowVersions(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, flowFilter *filter.Flow) ([]metadata.GetFlowVersionsFlowsConnectionNodesFlow, error) {
+	return internal.PaginateWithCheckpoint(ctx, perPage, checkpoint, "flowVersions", func(ctx context.Context, first int, after *string) ([]metadata.GetFlowVersionsFlowsConnectionNodesFlow, internal.PageInfo, error) {
+		resp, err := metadata.GetFlowVersions(ctx, client, first, after, flowFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.FlowsConnection.Nodes, &resp.FlowsConnection.PageInfo, nil
+	}, nil)
+}
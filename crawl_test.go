@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/filter"
+	"github.com/getsynq/connections-tableau/internal"
+	"github.com/getsynq/connections-tableau/output"
+)
+
+func TestCrawlStreams(t *testing.T) {
+	client := fakeClient{
+		"GetDatabaseTablesDefinitions": `[{"id": "t1", "name": "orders", "connectionType": "snowflake", "fullName": "[public].[orders]", "columns": [{"id": "c1"}]}]`,
+		"GetWorkbooks":                 `[{"id": "w1", "name": "Sales"}]`,
+		"GetColumnLineage":             `[{"id": "c1", "table": {"__typename": "DatabaseTable", "id": "t1"}, "referencedByFields": [{"__typename": "ColumnField", "id": "f1"}]}]`,
+	}
+	filters := &filter.Options{}
+	if err := filters.Compile(); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	writer, err := output.New(output.FormatNDJSON, &buf, output.Source{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := crawl(context.Background(), client, 100, nil, filters, writer, io.Discard)
+	if err != nil {
+		t.Fatalf("crawl() error = %v", err)
+	}
+	// streamed pages are not kept, only the lineage built from all of them
+	if len(got.DatabaseTables) != 0 || len(got.Workbooks) != 0 {
+		t.Errorf("crawl() kept streamed nodes: %d tables, %d workbooks", len(got.DatabaseTables), len(got.Workbooks))
+	}
+	if len(got.ColumnLineage) != 1 {
+		t.Errorf("crawl() lineage = %v, want one edge", got.ColumnLineage)
+	}
+	for _, entity := range []string{"databaseTables", "workbooks"} {
+		if !strings.Contains(buf.String(), `{"entity":"`+entity+`"`) {
+			t.Errorf("%s were not streamed:\n%s", entity, buf.String())
+		}
+	}
+	if !strings.Contains(buf.String(), `"identifier":{"canonical":"PUBLIC.ORDERS"`) {
+		t.Errorf("tables were streamed without their identifier:\n%s", buf.String())
+	}
+
+	got, err = crawl(context.Background(), client, 100, nil, filters, nil, io.Discard)
+	if err != nil {
+		t.Fatalf("crawl() error = %v", err)
+	}
+	if len(got.DatabaseTables) != 1 || len(got.Workbooks) != 1 {
+		t.Errorf("crawl() without writer = %d tables, %d workbooks, want one each", len(got.DatabaseTables), len(got.Workbooks))
+	}
+}
+
+func TestFetchDatabaseTableCountsResumes(t *testing.T) {
+	dir := t.TempDir()
+	checkpoint, err := internal.NewCheckpoint(dir, "https://tableau.example.com", "site", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fakeClient{
+		"GetDatabaseTableCounts": `[
+			{"__typename": "DatabaseServer", "id": "d1", "connectionType": "snowflake", "tablesConnection": {"totalCount": 2}},
+			{"__typename": "DatabaseServer", "id": "d2", "connectionType": "snowflake", "tablesConnection": {"totalCount": 3}},
+			{"__typename": "CloudFile", "id": "d3", "connectionType": "excel-direct", "tablesConnection": {"totalCount": 1}}
+		]`,
+	}
+	want := map[string]int{"snowflake": 5, "excel-direct": 1}
+	got, err := fetchDatabaseTableCounts(context.Background(), client, 100, checkpoint)
+	if err != nil {
+		t.Fatalf("fetchDatabaseTableCounts() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fetchDatabaseTableCounts() = %v, want %v", got, want)
+	}
+
+	// the counts are read back from the checkpoint without asking the server again
+	checkpoint, err = internal.ResumeCheckpoint(dir, "https://tableau.example.com", "site", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = fetchDatabaseTableCounts(context.Background(), fakeClient{}, 100, checkpoint)
+	if err != nil {
+		t.Fatalf("fetchDatabaseTableCounts() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fetchDatabaseTableCounts() resumed = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,306 @@
+package dbt
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getsynq/connections-tableau/ingest"
+	"github.com/getsynq/connections-tableau/model"
+	"gopkg.in/yaml.v2"
+)
+
+// What is declared as an exposure.
+const (
+	PerDashboard = "dashboard"
+	PerWorkbook  = "workbook"
+)
+
+// Maturities are the maturity levels dbt accepts for exposures.
+var Maturities = []string{"low", "medium", "high"}
+
+// Options control how exposures are built.
+type Options struct {
+	// Per is PerDashboard or PerWorkbook.
+	Per      string
+	Maturity string
+	// TableauUrl and Site are used for the URL of exposures, which is left out without TableauUrl.
+	TableauUrl string
+	Site       string
+}
+
+// File is the content of an exposures.yml.
+type File struct {
+	Version   int        `yaml:"version"`
+	Exposures []Exposure `yaml:"exposures"`
+}
+
+// Exposure is a dbt exposure, see https://docs.getdbt.com/reference/exposure-properties.
+type Exposure struct {
+	Name        string   `yaml:"name"`
+	Label       string   `yaml:"label,omitempty"`
+	Type        string   `yaml:"type"`
+	Maturity    string   `yaml:"maturity,omitempty"`
+	Url         string   `yaml:"url,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	DependsOn   []string `yaml:"depends_on"`
+	Owner       Owner    `yaml:"owner"`
+}
+
+type Owner struct {
+	Name  string `yaml:"name,omitempty"`
+	Email string `yaml:"email,omitempty"`
+}
+
+// Unmatched is a warehouse table which is neither a model nor a source of the dbt project.
+type Unmatched struct {
+	Table string
+	// Exposures are the names of the exposures reading the table.
+	Exposures []string
+}
+
+// Result are the exposures built from an export.
+type Result struct {
+	File      File
+	Unmatched []Unmatched
+	// Skipped are the names of dashboards or workbooks which read no table of the dbt project
+	// and are therefore not declared as exposures.
+	Skipped []string
+}
+
+// Write writes the exposures as YAML.
+func (r *Result) Write(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	if err := encoder.Encode(r.File); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// exposed is a dashboard or workbook with the upstream tables it reads.
+type exposed struct {
+	name        string
+	label       string
+	description string
+	url         string
+	owner       Owner
+	upstream    []interface{}
+}
+
+// Build declares the dashboards or workbooks of an export as exposures depending on the models and
+// sources their upstream tables are built as.
+func Build(response *model.Response, manifest *Manifest, options Options) *Result {
+	b := &builder{
+		relations: newRelations(manifest),
+		tables:    ingest.NewTables(response),
+		customSQL: map[string][]ingest.Table{},
+		names:     map[string]bool{},
+		unmatched: map[string][]string{},
+	}
+	b.indexCustomSQL(response)
+
+	result := &Result{File: File{Version: 2, Exposures: []Exposure{}}}
+	var all []exposed
+	if options.Per == PerWorkbook {
+		all = workbooks(response, options)
+	} else {
+		all = dashboards(response, options)
+	}
+	for _, e := range all {
+		e.name = b.uniqueName(e.name)
+		dependsOn := b.dependsOn(e)
+		if len(dependsOn) == 0 {
+			result.Skipped = append(result.Skipped, e.name)
+			continue
+		}
+		result.File.Exposures = append(result.File.Exposures, Exposure{
+			Name:        e.name,
+			Label:       e.label,
+			Type:        "dashboard",
+			Maturity:    options.Maturity,
+			Url:         e.url,
+			Description: e.description,
+			DependsOn:   dependsOn,
+			Owner:       e.owner,
+		})
+	}
+
+	for table, exposures := range b.unmatched {
+		result.Unmatched = append(result.Unmatched, Unmatched{Table: table, Exposures: exposures})
+	}
+	sort.Slice(result.Unmatched, func(i, j int) bool {
+		return result.Unmatched[i].Table < result.Unmatched[j].Table
+	})
+	return result
+}
+
+func dashboards(response *model.Response, options Options) []exposed {
+	result := make([]exposed, 0, len(response.Dashboards))
+	for _, dashboard := range response.Dashboards {
+		e := exposed{
+			name:        dashboard.Workbook.Name + "_" + dashboard.Name,
+			label:       dashboard.Name,
+			description: fmt.Sprintf("Tableau dashboard %s of workbook %s in project %s.", dashboard.Name, dashboard.Workbook.Name, dashboard.Workbook.ProjectName),
+			owner:       owner(dashboard.Workbook.Owner.Name, dashboard.Workbook.Owner.Username, dashboard.Workbook.Owner.Email),
+		}
+		if options.TableauUrl != "" {
+			e.url = ingest.ViewUrl(options.TableauUrl, options.Site, dashboard.Path)
+		}
+		for _, upstream := range dashboard.UpstreamTables {
+			e.upstream = append(e.upstream, upstream)
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// workbooks exposes workbooks with their own upstream tables and those of their sheets and
+// dashboards, which include custom SQL tables.
+func workbooks(response *model.Response, options Options) []exposed {
+	views := map[string][]interface{}{}
+	for _, sheet := range response.Sheets {
+		for _, upstream := range sheet.UpstreamTables {
+			views[sheet.Workbook.Id] = append(views[sheet.Workbook.Id], upstream)
+		}
+	}
+	for _, dashboard := range response.Dashboards {
+		for _, upstream := range dashboard.UpstreamTables {
+			views[dashboard.Workbook.Id] = append(views[dashboard.Workbook.Id], upstream)
+		}
+	}
+
+	result := make([]exposed, 0, len(response.Workbooks))
+	for _, workbook := range response.Workbooks {
+		e := exposed{
+			name:        workbook.Name,
+			label:       workbook.Name,
+			description: workbook.Description,
+			owner:       owner(workbook.Owner.Name, workbook.Owner.Username, workbook.Owner.Email),
+		}
+		if e.description == "" {
+			e.description = fmt.Sprintf("Tableau workbook %s in project %s.", workbook.Name, workbook.ProjectName)
+		}
+		if options.TableauUrl != "" {
+			e.url = workbookUrl(options.TableauUrl, options.Site, workbook.Uri)
+		}
+		for i := range workbook.UpstreamTables {
+			e.upstream = append(e.upstream, &workbook.UpstreamTables[i])
+		}
+		e.upstream = append(e.upstream, views[workbook.Id]...)
+		result = append(result, e)
+	}
+	return result
+}
+
+// workbookUrl links to a workbook by the numeric id at the end of its uri, e.g. sites/1/workbooks/42.
+func workbookUrl(tableauUrl, site, uri string) string {
+	id := uri[strings.LastIndex(uri, "/")+1:]
+	if id == "" {
+		return ""
+	}
+	tableauUrl = strings.TrimSuffix(tableauUrl, "/")
+	if site == "" {
+		return fmt.Sprintf("%s/#/workbooks/%s", tableauUrl, id)
+	}
+	return fmt.Sprintf("%s/#/site/%s/workbooks/%s", tableauUrl, site, id)
+}
+
+// owner uses the username when the display name is missing, dbt requires a name or an email.
+func owner(name, username, email string) Owner {
+	if name == "" {
+		name = username
+	}
+	return Owner{Name: name, Email: email}
+}
+
+type builder struct {
+	relations *relations
+	tables    *ingest.Tables
+	// customSQL are the warehouse tables read by custom SQL tables, by their id
+	customSQL map[string][]ingest.Table
+	names     map[string]bool
+	// unmatched are the names of the exposures reading a table, by its qualified name
+	unmatched map[string][]string
+}
+
+// indexCustomSQL collects the tables Tableau resolved for custom SQL queries and those parsed from them.
+func (b *builder) indexCustomSQL(response *model.Response) {
+	connectionTypes := map[string]string{}
+	for _, customSQL := range response.CustomSQLTables {
+		connectionTypes[customSQL.Id] = customSQL.ConnectionType
+		for i := range customSQL.Tables {
+			if table, ok := b.tables.Upstream(&customSQL.Tables[i]); ok {
+				b.customSQL[customSQL.Id] = append(b.customSQL[customSQL.Id], table)
+			}
+		}
+	}
+	for _, references := range response.CustomSQLReferences {
+		if references.Result == nil {
+			continue
+		}
+		for _, table := range references.Tables {
+			b.customSQL[references.CustomSQLTableId] = append(b.customSQL[references.CustomSQLTableId], ingest.Table{
+				Platform: ingest.Platform(connectionTypes[references.CustomSQLTableId]),
+				Database: table.Database,
+				Schema:   table.Schema,
+				Name:     table.Name,
+			})
+		}
+	}
+}
+
+// dependsOn matches the upstream tables of a dashboard or workbook and records those without a match.
+func (b *builder) dependsOn(e exposed) []string {
+	refs := map[string]bool{}
+	seen := map[string]bool{}
+	for _, upstream := range e.upstream {
+		for _, table := range b.resolve(upstream) {
+			name := table.QualifiedName()
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if node, ok := b.relations.match(table); ok {
+				refs[node.Ref()] = true
+			} else {
+				b.unmatched[name] = append(b.unmatched[name], e.name)
+			}
+		}
+	}
+	dependsOn := make([]string, 0, len(refs))
+	for ref := range refs {
+		dependsOn = append(dependsOn, ref)
+	}
+	sort.Strings(dependsOn)
+	return dependsOn
+}
+
+func (b *builder) resolve(upstream interface{}) []ingest.Table {
+	if node, ok := upstream.(interface{ GetId() string }); ok {
+		if tables, ok := b.customSQL[node.GetId()]; ok {
+			return tables
+		}
+	}
+	if table, ok := b.tables.Upstream(upstream); ok {
+		return []ingest.Table{table}
+	}
+	return nil
+}
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9]+`)
+
+// uniqueName turns a name into an identifier dbt accepts for exposures, made unique with a number.
+func (b *builder) uniqueName(name string) string {
+	name = strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		name = "tableau"
+	}
+	unique := name
+	for i := 2; b.names[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	b.names[unique] = true
+	return unique
+}
@@ -0,0 +1,108 @@
+package dbt
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/ingest"
+	"github.com/getsynq/connections-tableau/internal/fixture"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+)
+
+func manifest() *Manifest {
+	return &Manifest{
+		Nodes: map[string]*Node{
+			"model.shop.orders":    {ResourceType: "model", Name: "orders", Database: "analytics", Schema: "public"},
+			"model.shop.customers": {ResourceType: "model", Name: "dim_customers", Alias: "customers", Database: "analytics", Schema: "public"},
+			"test.shop.not_null":   {ResourceType: "test", Name: "not_null", Database: "analytics", Schema: "public"},
+		},
+		Sources: map[string]*Node{
+			"source.shop.raw.payments": {ResourceType: "source", SourceName: "raw", Name: "payments", Identifier: "PAYMENTS", Database: "RAW", Schema: "STRIPE"},
+		},
+	}
+}
+
+// export adds a second dashboard of the same name to the fixture, whose table is not in the manifest.
+func export(t *testing.T) *model.Response {
+	response := fixture.Export(t)
+	dashboard := response.Dashboards[0]
+	dashboard.Id, dashboard.Path = "d2", "Sales/Overview2"
+	dashboard.UpstreamTables = []metadata.GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable{
+		&metadata.GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable{Typename: "DatabaseTable", Id: "t3", FullName: "[scratch].[tmp]", ConnectionType: "snowflake"},
+	}
+	response.Dashboards = append(response.Dashboards, dashboard)
+	return response
+}
+
+func TestBuild(t *testing.T) {
+	result := Build(export(t), manifest(), Options{Per: PerDashboard, Maturity: "high", TableauUrl: "https://tableau.example.com/", Site: "acme"})
+
+	want := []Exposure{{
+		Name:        "sales_overview",
+		Label:       "Overview",
+		Type:        "dashboard",
+		Maturity:    "high",
+		Url:         "https://tableau.example.com/#/site/acme/views/Sales/Overview",
+		Description: "Tableau dashboard Overview of workbook Sales in project Finance.",
+		DependsOn:   []string{"ref('dim_customers')", "ref('orders')", "source('raw', 'payments')"},
+		Owner:       Owner{Name: "jo", Email: "jo@example.com"},
+	}}
+	if !reflect.DeepEqual(result.File.Exposures, want) {
+		t.Errorf("Exposures = %+v, want %+v", result.File.Exposures, want)
+	}
+	if want := []string{"sales_overview_2"}; !reflect.DeepEqual(result.Skipped, want) {
+		t.Errorf("Skipped = %v, want %v", result.Skipped, want)
+	}
+	wantUnmatched := []Unmatched{
+		{Table: "SCRATCH.TMP", Exposures: []string{"sales_overview_2"}},
+		{Table: "stripe.refunds", Exposures: []string{"sales_overview"}},
+	}
+	if !reflect.DeepEqual(result.Unmatched, wantUnmatched) {
+		t.Errorf("Unmatched = %+v, want %+v", result.Unmatched, wantUnmatched)
+	}
+
+	var buf bytes.Buffer
+	if err := result.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "version: 2\nexposures:\n- name: sales_overview\n") {
+		t.Errorf("unexpected YAML:\n%s", buf.String())
+	}
+}
+
+func TestBuildPerWorkbook(t *testing.T) {
+	result := Build(fixture.Export(t), manifest(), Options{Per: PerWorkbook, TableauUrl: "https://tableau.example.com"})
+
+	if len(result.File.Exposures) != 1 {
+		t.Fatalf("Exposures = %+v, want one", result.File.Exposures)
+	}
+	exposure := result.File.Exposures[0]
+	if exposure.Url != "https://tableau.example.com/#/workbooks/42" {
+		t.Errorf("Url = %s", exposure.Url)
+	}
+	if want := []string{"ref('dim_customers')", "ref('orders')", "source('raw', 'payments')"}; !reflect.DeepEqual(exposure.DependsOn, want) {
+		t.Errorf("DependsOn = %v, want %v", exposure.DependsOn, want)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	r := newRelations(&Manifest{Nodes: map[string]*Node{
+		"model.a.orders":    {ResourceType: "model", Name: "orders", Database: "prod", Schema: "marts"},
+		"model.a.orders_eu": {ResourceType: "model", Name: "orders_eu", Alias: "orders", Database: "prod_eu", Schema: "marts"},
+	}})
+
+	if node, ok := r.match(tableOf("PROD", "MARTS", "ORDERS")); !ok || node.Name != "orders" {
+		t.Errorf("match(PROD.MARTS.ORDERS) = %v, %v", node, ok)
+	}
+	// ambiguous without the database
+	if node, ok := r.match(tableOf("", "marts", "orders")); ok {
+		t.Errorf("match(marts.orders) = %v, want no match", node)
+	}
+}
+
+func tableOf(database, schema, name string) ingest.Table {
+	return ingest.Table{Database: database, Schema: schema, Name: name}
+}
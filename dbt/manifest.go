@@ -0,0 +1,130 @@
+// Package dbt declares Tableau dashboards and workbooks as dbt exposures, matching the warehouse
+// tables they read onto the models and sources of a dbt project.
+package dbt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/getsynq/connections-tableau/ingest"
+)
+
+// Manifest is the part of a dbt manifest.json needed to find the relation of a model or source.
+type Manifest struct {
+	Nodes   map[string]*Node `json:"nodes"`
+	Sources map[string]*Node `json:"sources"`
+}
+
+// Node is a model, seed, snapshot or source of a dbt project.
+type Node struct {
+	UniqueId     string `json:"unique_id"`
+	ResourceType string `json:"resource_type"`
+	Name         string `json:"name"`
+	Alias        string `json:"alias"`
+	Identifier   string `json:"identifier"`
+	SourceName   string `json:"source_name"`
+	Database     string `json:"database"`
+	Schema       string `json:"schema"`
+}
+
+// ReadManifest reads a manifest.json written by dbt compile, run or build.
+func ReadManifest(path string) (*Manifest, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse dbt manifest %s: %w", path, err)
+	}
+	return manifest, nil
+}
+
+// Relation is the name of the table or view the node is built as.
+func (n *Node) Relation() string {
+	switch {
+	case n.Identifier != "":
+		return n.Identifier
+	case n.Alias != "":
+		return n.Alias
+	}
+	return n.Name
+}
+
+// Ref is how an exposure depends on the node, ref('orders') or source('raw', 'orders').
+func (n *Node) Ref() string {
+	if n.ResourceType == "source" {
+		return fmt.Sprintf("source('%s', '%s')", n.SourceName, n.Name)
+	}
+	return fmt.Sprintf("ref('%s')", n.Name)
+}
+
+// relations finds the nodes of a manifest by the relation they are built as.
+type relations struct {
+	byName       map[string]*Node
+	bySchemaName map[string][]*Node
+}
+
+// refable are the resource types which are tables or views and can be referenced with ref().
+var refable = map[string]bool{"model": true, "seed": true, "snapshot": true}
+
+func newRelations(manifest *Manifest) *relations {
+	r := &relations{byName: map[string]*Node{}, bySchemaName: map[string][]*Node{}}
+	add := func(node *Node) {
+		r.byName[key(node.Database, node.Schema, node.Relation())] = node
+		schemaName := key("", node.Schema, node.Relation())
+		r.bySchemaName[schemaName] = append(r.bySchemaName[schemaName], node)
+	}
+	// sources first, so a model built into the same relation as a source wins
+	for _, id := range sortedKeys(manifest.Sources) {
+		add(manifest.Sources[id])
+	}
+	for _, id := range sortedKeys(manifest.Nodes) {
+		if node := manifest.Nodes[id]; refable[node.ResourceType] {
+			add(node)
+		}
+	}
+	return r
+}
+
+// match finds the node built as a table. Tables without a database, or whose database Tableau knows
+// by another name such as the host, are matched on schema and name when that is unambiguous.
+func (r *relations) match(table ingest.Table) (*Node, bool) {
+	if table.Database != "" {
+		if node, ok := r.byName[key(table.Database, table.Schema, table.Name)]; ok {
+			return node, true
+		}
+	}
+	nodes := r.bySchemaName[key("", table.Schema, table.Name)]
+	if len(nodes) == 1 || (len(nodes) > 1 && sameRelation(nodes)) {
+		return nodes[len(nodes)-1], true
+	}
+	return nil, false
+}
+
+// sameRelation reports whether nodes, like a source and the model replacing it, share a database too.
+func sameRelation(nodes []*Node) bool {
+	for _, node := range nodes[1:] {
+		if !strings.EqualFold(node.Database, nodes[0].Database) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(nodes map[string]*Node) []string {
+	keys := make([]string, 0, len(nodes))
+	for key := range nodes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// key compares identifiers case-insensitively, as quoting and case folding differ between Tableau and dbt.
+func key(database, schema, name string) string {
+	return strings.ToLower(database + "." + schema + "." + name)
+}
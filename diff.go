@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/getsynq/connections-tableau/diff"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// errBreakingChanges makes the process exit with a non-zero code without printing a stack trace.
+var errBreakingChanges = errors.New("breaking changes found")
+
+var DiffJson bool
+var DiffFailOnBreaking bool
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <before> <after>",
+	Short: "Compare two exports and report added, removed and changed tables and columns",
+	Args:  cobra.ExactArgs(2),
+	// breaking changes are reported as an error, which is not a usage problem
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		before, err := readExport(args[0])
+		if err != nil {
+			return err
+		}
+		after, err := readExport(args[1])
+		if err != nil {
+			return err
+		}
+
+		report := diff.Compare(before, after)
+		if DiffJson {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			err = encoder.Encode(report)
+		} else {
+			err = report.WriteText(os.Stdout)
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to write report")
+		}
+
+		if DiffFailOnBreaking && report.Breaking > 0 {
+			return errBreakingChanges
+		}
+		return nil
+	},
+}
+
+func init() {
+	diffCmd.Flags().BoolVar(&DiffJson, "json", false, "Write the report as JSON")
+	diffCmd.Flags().BoolVar(&DiffFailOnBreaking, "fail-on-breaking", false, "Exit with code 1 when tables or columns were removed or changed their type or connection type")
+	rootCmd.AddCommand(diffCmd)
+}
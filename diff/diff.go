@@ -0,0 +1,185 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/getsynq/connections-tableau/model"
+)
+
+type Kind string
+
+const (
+	TableAdded            Kind = "tableAdded"
+	TableRemoved          Kind = "tableRemoved"
+	ColumnAdded           Kind = "columnAdded"
+	ColumnRemoved         Kind = "columnRemoved"
+	RemoteTypeChanged     Kind = "remoteTypeChanged"
+	DescriptionChanged    Kind = "descriptionChanged"
+	ConnectionTypeChanged Kind = "connectionTypeChanged"
+)
+
+// Breaking reports whether consumers of the table can break because of the change.
+func (k Kind) Breaking() bool {
+	switch k {
+	case TableRemoved, ColumnRemoved, RemoteTypeChanged, ConnectionTypeChanged:
+		return true
+	}
+	return false
+}
+
+type Change struct {
+	Kind     Kind   `json:"kind"`
+	Breaking bool   `json:"breaking"`
+	TableId  string `json:"tableId"`
+	Table    string `json:"table"`
+	Column   string `json:"column,omitempty"`
+	Before   string `json:"before,omitempty"`
+	After    string `json:"after,omitempty"`
+}
+
+type Report struct {
+	Changes  []Change `json:"changes"`
+	Breaking int      `json:"breaking"`
+}
+
+// table is what is compared of both database and custom SQL tables.
+type table struct {
+	id             string
+	name           string
+	connectionType string
+	description    string
+	columns        map[string]string
+}
+
+// Compare lists the changes of database and custom SQL tables between two exports, matching tables by
+// their Tableau id and columns by name.
+func Compare(before, after *model.Response) *Report {
+	beforeTables, afterTables := tables(before), tables(after)
+	report := &Report{Changes: make([]Change, 0)}
+
+	for _, id := range sortedIds(beforeTables) {
+		if _, ok := afterTables[id]; !ok {
+			report.add(Change{Kind: TableRemoved, TableId: id, Table: beforeTables[id].name})
+		}
+	}
+	for _, id := range sortedIds(afterTables) {
+		a := afterTables[id]
+		b, ok := beforeTables[id]
+		if !ok {
+			report.add(Change{Kind: TableAdded, TableId: id, Table: a.name})
+			continue
+		}
+		if b.connectionType != a.connectionType {
+			report.add(Change{Kind: ConnectionTypeChanged, TableId: id, Table: a.name, Before: b.connectionType, After: a.connectionType})
+		}
+		if b.description != a.description {
+			report.add(Change{Kind: DescriptionChanged, TableId: id, Table: a.name, Before: b.description, After: a.description})
+		}
+		for _, column := range sortedNames(b.columns) {
+			if _, ok := a.columns[column]; !ok {
+				report.add(Change{Kind: ColumnRemoved, TableId: id, Table: a.name, Column: column, Before: b.columns[column]})
+			}
+		}
+		for _, column := range sortedNames(a.columns) {
+			remoteType, ok := b.columns[column]
+			if !ok {
+				report.add(Change{Kind: ColumnAdded, TableId: id, Table: a.name, Column: column, After: a.columns[column]})
+			} else if remoteType != a.columns[column] {
+				report.add(Change{Kind: RemoteTypeChanged, TableId: id, Table: a.name, Column: column, Before: remoteType, After: a.columns[column]})
+			}
+		}
+	}
+	return report
+}
+
+func (r *Report) add(change Change) {
+	change.Breaking = change.Kind.Breaking()
+	if change.Breaking {
+		r.Breaking++
+	}
+	r.Changes = append(r.Changes, change)
+}
+
+// WriteText writes the report as one line per change.
+func (r *Report) WriteText(w io.Writer) error {
+	if len(r.Changes) == 0 {
+		_, err := fmt.Fprintln(w, "No changes")
+		return err
+	}
+	for _, change := range r.Changes {
+		marker := " "
+		if change.Breaking {
+			marker = "!"
+		}
+		var line string
+		switch change.Kind {
+		case TableAdded:
+			line = fmt.Sprintf("+ table %s", change.Table)
+		case TableRemoved:
+			line = fmt.Sprintf("- table %s", change.Table)
+		case ColumnAdded:
+			line = fmt.Sprintf("+ column %s.%s %s", change.Table, change.Column, change.After)
+		case ColumnRemoved:
+			line = fmt.Sprintf("- column %s.%s %s", change.Table, change.Column, change.Before)
+		case RemoteTypeChanged:
+			line = fmt.Sprintf("~ column %s.%s type %s -> %s", change.Table, change.Column, change.Before, change.After)
+		case DescriptionChanged:
+			line = fmt.Sprintf("~ table %s description %q -> %q", change.Table, change.Before, change.After)
+		case ConnectionTypeChanged:
+			line = fmt.Sprintf("~ table %s connection type %s -> %s", change.Table, change.Before, change.After)
+		}
+		if _, err := fmt.Fprintf(w, "%s %s\n", marker, line); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d changes, %d breaking\n", len(r.Changes), r.Breaking)
+	return err
+}
+
+func tables(export *model.Response) map[string]*table {
+	result := map[string]*table{}
+	for _, t := range export.DatabaseTables {
+		name := t.FullName
+		if name == "" {
+			name = t.Name
+		}
+		columns := map[string]string{}
+		for _, column := range t.Columns {
+			columns[column.Name] = string(column.RemoteType)
+		}
+		result[t.Id] = &table{id: t.Id, name: name, connectionType: t.ConnectionType, description: t.Description, columns: columns}
+	}
+	for _, t := range export.CustomSQLTables {
+		columns := map[string]string{}
+		for _, column := range t.Columns {
+			columns[column.Name] = string(column.RemoteType)
+		}
+		result[t.Id] = &table{id: t.Id, name: t.Name, connectionType: t.ConnectionType, description: t.Description, columns: columns}
+	}
+	return result
+}
+
+func sortedIds(tables map[string]*table) []string {
+	ids := make([]string, 0, len(tables))
+	for id := range tables {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if tables[ids[i]].name != tables[ids[j]].name {
+			return tables[ids[i]].name < tables[ids[j]].name
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+func sortedNames(columns map[string]string) []string {
+	names := make([]string, 0, len(columns))
+	for name := range columns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
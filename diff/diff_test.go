@@ -0,0 +1,67 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+)
+
+type column = metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn
+
+func export(tables ...*metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) *model.Response {
+	return &model.Response{DatabaseTables: model.DatabaseTables(tables...)}
+}
+
+func TestCompare(t *testing.T) {
+	orders := func(connectionType, description string, columns ...column) *metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable {
+		return &metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{
+			Id: "t1", FullName: "db.public.orders", ConnectionType: connectionType, Description: description, Columns: columns,
+		}
+	}
+	customers := &metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{Id: "t2", FullName: "db.public.customers"}
+
+	tests := []struct {
+		name   string
+		before *model.Response
+		after  *model.Response
+		want   []Change
+	}{
+		{
+			name:   "unchanged",
+			before: export(orders("snowflake", "", column{Name: "id", RemoteType: "I8"})),
+			after:  export(orders("snowflake", "", column{Name: "id", RemoteType: "I8"})),
+			want:   []Change{},
+		},
+		{
+			name:   "tables added and removed",
+			before: export(customers),
+			after:  export(orders("snowflake", "")),
+			want: []Change{
+				{Kind: TableRemoved, Breaking: true, TableId: "t2", Table: "db.public.customers"},
+				{Kind: TableAdded, TableId: "t1", Table: "db.public.orders"},
+			},
+		},
+		{
+			name:   "columns and table properties",
+			before: export(orders("redshift", "Orders", column{Name: "id", RemoteType: "I4"}, column{Name: "note", RemoteType: "WSTR"})),
+			after:  export(orders("snowflake", "All orders", column{Name: "id", RemoteType: "I8"}, column{Name: "total", RemoteType: "R8"})),
+			want: []Change{
+				{Kind: ConnectionTypeChanged, Breaking: true, TableId: "t1", Table: "db.public.orders", Before: "redshift", After: "snowflake"},
+				{Kind: DescriptionChanged, TableId: "t1", Table: "db.public.orders", Before: "Orders", After: "All orders"},
+				{Kind: ColumnRemoved, Breaking: true, TableId: "t1", Table: "db.public.orders", Column: "note", Before: "WSTR"},
+				{Kind: RemoteTypeChanged, Breaking: true, TableId: "t1", Table: "db.public.orders", Column: "id", Before: "I4", After: "I8"},
+				{Kind: ColumnAdded, TableId: "t1", Table: "db.public.orders", Column: "total", After: "R8"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compare(tt.before, tt.after)
+			if !reflect.DeepEqual(got.Changes, tt.want) {
+				t.Errorf("Compare() = %+v, want %+v", got.Changes, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/getsynq/connections-tableau/dbt"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var ExposuresManifest string
+var ExposuresOutput string
+var ExposuresPer string
+var ExposuresMaturity string
+var ExposuresTableauUrl string
+var ExposuresSite string
+
+var exposuresCmd = &cobra.Command{
+	Use:   "exposures <export.json>",
+	Short: "Declare dashboards or workbooks of an export as dbt exposures of the models and sources they read",
+	Args:  cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if ExposuresPer != dbt.PerDashboard && ExposuresPer != dbt.PerWorkbook {
+			return errors.Errorf("unknown --per %q, expected %s or %s", ExposuresPer, dbt.PerDashboard, dbt.PerWorkbook)
+		}
+		for _, maturity := range dbt.Maturities {
+			if ExposuresMaturity == maturity {
+				return nil
+			}
+		}
+		return errors.Errorf("unknown --maturity %q, expected one of %s", ExposuresMaturity, strings.Join(dbt.Maturities, ", "))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		response, err := readExport(args[0])
+		if err != nil {
+			return err
+		}
+		manifest, err := dbt.ReadManifest(ExposuresManifest)
+		if err != nil {
+			return errors.Wrap(err, "failed to read dbt manifest")
+		}
+
+		result := dbt.Build(response, manifest, dbt.Options{
+			Per:        ExposuresPer,
+			Maturity:   ExposuresMaturity,
+			TableauUrl: ExposuresTableauUrl,
+			Site:       ExposuresSite,
+		})
+
+		file, err := os.Create(ExposuresOutput)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create %s", ExposuresOutput)
+		}
+		defer file.Close()
+		if err := result.Write(file); err != nil {
+			return errors.Wrapf(err, "failed to write %s", ExposuresOutput)
+		}
+		if err := file.Close(); err != nil {
+			return errors.Wrapf(err, "failed to write %s", ExposuresOutput)
+		}
+
+		fmt.Printf("Wrote %d exposures to %s\n", len(result.File.Exposures), ExposuresOutput)
+		if len(result.Skipped) > 0 {
+			fmt.Printf("%d %ss read no model or source and were left out: %s\n", len(result.Skipped), ExposuresPer, strings.Join(result.Skipped, ", "))
+		}
+		if len(result.Unmatched) > 0 {
+			fmt.Printf("%d tables could not be matched to a model or source:\n", len(result.Unmatched))
+			for _, unmatched := range result.Unmatched {
+				fmt.Printf("  %s (%s)\n", unmatched.Table, strings.Join(unmatched.Exposures, ", "))
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	exposuresCmd.Flags().StringVar(&ExposuresManifest, "manifest", "target/manifest.json", "manifest.json of the dbt project, written by dbt compile, run or build")
+	exposuresCmd.Flags().StringVarP(&ExposuresOutput, "output", "o", "exposures.yml", "File to write the exposures to")
+	exposuresCmd.Flags().StringVar(&ExposuresPer, "per", dbt.PerDashboard, "Declare an exposure per dashboard or per workbook")
+	exposuresCmd.Flags().StringVar(&ExposuresMaturity, "maturity", "medium", "Maturity of the exposures: low, medium or high")
+	exposuresCmd.Flags().StringVar(&ExposuresTableauUrl, "url", "", "Full URL of Tableau used for the url of exposures (e.g. https://prod-uk-a.online.tableau.com)")
+	exposuresCmd.Flags().StringVar(&ExposuresSite, "site", "", "Site name used for the url of exposures")
+	rootCmd.AddCommand(exposuresCmd)
+}
@@ -0,0 +1,63 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+const AllConnectionTypes = "all"
+
+var DefaultConnectionTypes = []string{"bigquery", "snowflake", "redshift", "clickhouse"}
+
+type ConnectionTypes struct {
+	Include []string
+	Exclude []string
+}
+
+func (c ConnectionTypes) All() bool {
+	for _, connectionType := range c.Include {
+		if strings.EqualFold(connectionType, AllConnectionTypes) {
+			return true
+		}
+	}
+	return len(c.Include) == 0
+}
+
+// Validate fails when every included connection type is excluded as well, nothing would be accepted
+// while the empty Within would request every connection type from the server.
+func (c ConnectionTypes) Validate() error {
+	if !c.All() && len(c.Within()) == 0 {
+		return fmt.Errorf("every connection type of --connection-type (%s) is excluded with --exclude-connection-type", strings.Join(c.Include, ", "))
+	}
+	return nil
+}
+
+// Within is the list of connection types to request from the server, nil when every type is accepted.
+func (c ConnectionTypes) Within() []string {
+	if c.All() {
+		return nil
+	}
+	within := make([]string, 0, len(c.Include))
+	for _, connectionType := range c.Include {
+		if !contains(c.Exclude, connectionType) {
+			within = append(within, strings.ToLower(connectionType))
+		}
+	}
+	return within
+}
+
+func (c ConnectionTypes) Accepts(connectionType string) bool {
+	if contains(c.Exclude, connectionType) {
+		return false
+	}
+	return c.All() || contains(c.Include, connectionType)
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
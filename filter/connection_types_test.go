@@ -0,0 +1,63 @@
+package filter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConnectionTypes(t *testing.T) {
+	tests := []struct {
+		connectionTypes ConnectionTypes
+		within          []string
+		valid           bool
+		accepts         map[string]bool
+	}{
+		{
+			connectionTypes: ConnectionTypes{Include: []string{"snowflake", "BigQuery"}},
+			within:          []string{"snowflake", "bigquery"},
+			valid:           true,
+			accepts:         map[string]bool{"snowflake": true, "Snowflake": true, "bigquery": true, "redshift": false},
+		},
+		{
+			connectionTypes: ConnectionTypes{Include: []string{"snowflake", "redshift"}, Exclude: []string{"Redshift"}},
+			within:          []string{"snowflake"},
+			valid:           true,
+			accepts:         map[string]bool{"snowflake": true, "redshift": false},
+		},
+		{
+			connectionTypes: ConnectionTypes{Include: []string{"ALL"}, Exclude: []string{"excel-direct"}},
+			within:          nil,
+			valid:           true,
+			accepts:         map[string]bool{"snowflake": true, "sqlserver": true, "excel-direct": false, "Excel-Direct": false},
+		},
+		{
+			connectionTypes: ConnectionTypes{},
+			within:          nil,
+			valid:           true,
+			accepts:         map[string]bool{"snowflake": true, "sqlserver": true},
+		},
+		{
+			// nothing is left, which would request every connection type from the server
+			connectionTypes: ConnectionTypes{Include: []string{"snowflake"}, Exclude: []string{"SNOWFLAKE"}},
+			within:          []string{},
+			valid:           false,
+			accepts:         map[string]bool{"snowflake": false, "redshift": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.connectionTypes.Include, ",")+"-"+strings.Join(tt.connectionTypes.Exclude, ","), func(t *testing.T) {
+			if got := tt.connectionTypes.Within(); !reflect.DeepEqual(got, tt.within) {
+				t.Errorf("Within() = %#v, want %#v", got, tt.within)
+			}
+			if err := tt.connectionTypes.Validate(); (err == nil) != tt.valid {
+				t.Errorf("Validate() error = %v, want valid %v", err, tt.valid)
+			}
+			for connectionType, want := range tt.accepts {
+				if got := tt.connectionTypes.Accepts(connectionType); got != want {
+					t.Errorf("Accepts(%s) = %v, want %v", connectionType, got, want)
+				}
+			}
+		})
+	}
+}
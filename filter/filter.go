@@ -0,0 +1,108 @@
+package filter
+
+// The types below are bound to the `*_Filter` inputs of the Metadata API, fields which are not set are omitted from the request.
+
+type DatabaseTable struct {
+	IdWithin             []string `json:"idWithin,omitempty"`
+	ConnectionTypeWithin []string `json:"connectionTypeWithin,omitempty"`
+	ProjectNameWithin    []string `json:"projectNameWithin,omitempty"`
+	SchemaWithin         []string `json:"schemaWithin,omitempty"`
+	NameWithin           []string `json:"nameWithin,omitempty"`
+	IsEmbedded           *bool    `json:"isEmbedded,omitempty"`
+	IsCertified          *bool    `json:"isCertified,omitempty"`
+	HasActiveWarning     *bool    `json:"hasActiveWarning,omitempty"`
+}
+
+type Workbook struct {
+	IdWithin          []string `json:"idWithin,omitempty"`
+	ProjectNameWithin []string `json:"projectNameWithin,omitempty"`
+	NameWithin        []string `json:"nameWithin,omitempty"`
+}
+
+type PublishedDatasource struct {
+	IdWithin          []string `json:"idWithin,omitempty"`
+	ProjectNameWithin []string `json:"projectNameWithin,omitempty"`
+	NameWithin        []string `json:"nameWithin,omitempty"`
+	IsCertified       *bool    `json:"isCertified,omitempty"`
+	HasActiveWarning  *bool    `json:"hasActiveWarning,omitempty"`
+}
+
+type EmbeddedDatasource struct {
+	IdWithin   []string `json:"idWithin,omitempty"`
+	NameWithin []string `json:"nameWithin,omitempty"`
+}
+
+type CustomSQLTable struct {
+	IdWithin []string `json:"idWithin,omitempty"`
+}
+
+type Sheet struct {
+	IdWithin []string `json:"idWithin,omitempty"`
+}
+
+type Dashboard struct {
+	IdWithin []string `json:"idWithin,omitempty"`
+}
+
+type Column struct {
+	IdWithin []string `json:"idWithin,omitempty"`
+}
+
+type CalculatedField struct {
+	IdWithin []string `json:"idWithin,omitempty"`
+}
+
+type Flow struct {
+	IdWithin          []string `json:"idWithin,omitempty"`
+	ProjectNameWithin []string `json:"projectNameWithin,omitempty"`
+}
+
+// WithIds returns a copy of the filter, which may be nil, narrowed down to the given ids.
+func (f *DatabaseTable) WithIds(ids []string) *DatabaseTable {
+	var narrowed DatabaseTable
+	if f != nil {
+		narrowed = *f
+	}
+	narrowed.IdWithin = ids
+	return &narrowed
+}
+
+// WithIds returns a copy of the filter, which may be nil, narrowed down to the given ids.
+func (f *Workbook) WithIds(ids []string) *Workbook {
+	var narrowed Workbook
+	if f != nil {
+		narrowed = *f
+	}
+	narrowed.IdWithin = ids
+	return &narrowed
+}
+
+// WithIds returns a copy of the filter, which may be nil, narrowed down to the given ids.
+func (f *PublishedDatasource) WithIds(ids []string) *PublishedDatasource {
+	var narrowed PublishedDatasource
+	if f != nil {
+		narrowed = *f
+	}
+	narrowed.IdWithin = ids
+	return &narrowed
+}
+
+// WithIds returns a copy of the filter, which may be nil, narrowed down to the given ids.
+func (f *EmbeddedDatasource) WithIds(ids []string) *EmbeddedDatasource {
+	var narrowed EmbeddedDatasource
+	if f != nil {
+		narrowed = *f
+	}
+	narrowed.IdWithin = ids
+	return &narrowed
+}
+
+// WithIds returns a copy of the filter, which may be nil, narrowed down to the given ids.
+func (f *Flow) WithIds(ids []string) *Flow {
+	var narrowed Flow
+	if f != nil {
+		narrowed = *f
+	}
+	narrowed.IdWithin = ids
+	return &narrowed
+}
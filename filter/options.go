@@ -0,0 +1,123 @@
+package filter
+
+type Options struct {
+	ConnectionTypes  ConnectionTypes
+	Projects         Patterns
+	Databases        Patterns
+	Schemas          Patterns
+	Tables           Patterns
+	Workbooks        Patterns
+	Datasources      Patterns
+	IsEmbedded       *bool
+	IsCertified      *bool
+	HasActiveWarning *bool
+
+	projects, databases, schemas, tables, workbooks, datasources *Matcher
+}
+
+// Compile prepares the patterns for client side matching, it has to be called before any of the Accepts methods.
+func (o *Options) Compile() error {
+	if err := o.ConnectionTypes.Validate(); err != nil {
+		return err
+	}
+	for _, c := range []struct {
+		patterns Patterns
+		matcher  **Matcher
+	}{
+		{o.Projects, &o.projects},
+		{o.Databases, &o.databases},
+		{o.Schemas, &o.schemas},
+		{o.Tables, &o.tables},
+		{o.Workbooks, &o.workbooks},
+		{o.Datasources, &o.datasources},
+	} {
+		matcher, err := c.patterns.Compile()
+		if err != nil {
+			return err
+		}
+		*c.matcher = matcher
+	}
+	return nil
+}
+
+func (o *Options) DatabaseTableFilter() *DatabaseTable {
+	return &DatabaseTable{
+		ConnectionTypeWithin: o.ConnectionTypes.Within(),
+		ProjectNameWithin:    o.Projects.Within(),
+		SchemaWithin:         o.Schemas.Within(),
+		NameWithin:           o.Tables.Within(),
+		IsEmbedded:           o.IsEmbedded,
+		IsCertified:          o.IsCertified,
+		HasActiveWarning:     o.HasActiveWarning,
+	}
+}
+
+func (o *Options) WorkbookFilter() *Workbook {
+	return &Workbook{
+		ProjectNameWithin: o.Projects.Within(),
+		NameWithin:        o.Workbooks.Within(),
+	}
+}
+
+func (o *Options) PublishedDatasourceFilter() *PublishedDatasource {
+	return &PublishedDatasource{
+		ProjectNameWithin: o.Projects.Within(),
+		NameWithin:        o.Datasources.Within(),
+		IsCertified:       o.IsCertified,
+		HasActiveWarning:  o.HasActiveWarning,
+	}
+}
+
+func (o *Options) EmbeddedDatasourceFilter() *EmbeddedDatasource {
+	return &EmbeddedDatasource{
+		NameWithin: o.Datasources.Within(),
+	}
+}
+
+func (o *Options) FlowFilter() *Flow {
+	return &Flow{
+		ProjectNameWithin: o.Projects.Within(),
+	}
+}
+
+func (o *Options) AcceptsDatabaseTable(connectionType, projectName, databaseName, schema, name string) bool {
+	return o.ConnectionTypes.Accepts(connectionType) &&
+		o.projects.Match(projectName) &&
+		o.databases.Match(databaseName) &&
+		o.schemas.Match(schema) &&
+		o.tables.Match(name)
+}
+
+func (o *Options) AcceptsCustomSQLTable(connectionType, databaseName string) bool {
+	return o.ConnectionTypes.Accepts(connectionType) && o.databases.Match(databaseName)
+}
+
+func (o *Options) AcceptsDatasource(projectName, name string) bool {
+	return o.projects.Match(projectName) && o.datasources.Match(name)
+}
+
+// AcceptsWorkbook is also used for sheets and dashboards, which are filtered by the workbook containing them.
+func (o *Options) AcceptsWorkbook(projectName, name string) bool {
+	return o.projects.Match(projectName) && o.workbooks.Match(name)
+}
+
+func (o *Options) AcceptsFlow(projectName string) bool {
+	return o.projects.Match(projectName)
+}
+
+// ScopesWorkbooks reports whether only some workbooks are accepted, the sheets and dashboards of the
+// accepted ones are then requested by id as the API cannot filter them by workbook.
+func (o *Options) ScopesWorkbooks() bool {
+	return len(o.Projects) > 0 || len(o.Workbooks) > 0
+}
+
+// AcceptsAll reports whether no filter is set, so that lineage does not have to be requested by id.
+func (o *Options) AcceptsAll() bool {
+	for _, patterns := range []Patterns{o.Projects, o.Databases, o.Schemas, o.Tables, o.Workbooks, o.Datasources} {
+		if len(patterns) > 0 {
+			return false
+		}
+	}
+	return o.ConnectionTypes.All() && len(o.ConnectionTypes.Exclude) == 0 &&
+		o.IsEmbedded == nil && o.IsCertified == nil && o.HasActiveWarning == nil
+}
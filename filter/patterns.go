@@ -0,0 +1,98 @@
+package filter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Patterns are names to match, either exactly, as a glob (`sales_*`) or as a regular expression wrapped in slashes (`/^fct_.+$/`).
+type Patterns []string
+
+func isRegexp(pattern string) bool {
+	return len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/")
+}
+
+func isGlob(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+// Within returns the names for a server side `...Within` filter, nil when any pattern has to be matched client side.
+func (p Patterns) Within() []string {
+	if len(p) == 0 {
+		return nil
+	}
+	for _, pattern := range p {
+		if isRegexp(pattern) || isGlob(pattern) {
+			return nil
+		}
+	}
+	return p
+}
+
+func (p Patterns) Compile() (*Matcher, error) {
+	m := &Matcher{}
+	for _, pattern := range p {
+		var expr string
+		switch {
+		case isRegexp(pattern):
+			expr = pattern[1 : len(pattern)-1]
+		case isGlob(pattern):
+			expr = "^" + globToRegexp(pattern) + "$"
+		default:
+			expr = "^" + regexp.QuoteMeta(pattern) + "$"
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pattern %s", pattern)
+		}
+		m.res = append(m.res, re)
+	}
+	return m, nil
+}
+
+func globToRegexp(glob string) string {
+	var b strings.Builder
+	inClass := false
+	for _, r := range glob {
+		switch {
+		case inClass:
+			if r == ']' {
+				inClass = false
+			}
+			if r == '\\' {
+				b.WriteString(`\\`)
+				continue
+			}
+			b.WriteRune(r)
+		case r == '*':
+			b.WriteString(".*")
+		case r == '?':
+			b.WriteString(".")
+		case r == '[':
+			inClass = true
+			b.WriteRune(r)
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return b.String()
+}
+
+type Matcher struct {
+	res []*regexp.Regexp
+}
+
+// Match is true when there are no patterns or any of them matches.
+func (m *Matcher) Match(name string) bool {
+	if m == nil || len(m.res) == 0 {
+		return true
+	}
+	for _, re := range m.res {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
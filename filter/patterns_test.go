@@ -0,0 +1,52 @@
+package filter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPatterns(t *testing.T) {
+	tests := []struct {
+		patterns Patterns
+		within   []string
+		matches  map[string]bool
+	}{
+		{
+			patterns: Patterns{"Finance", "Sales"},
+			within:   []string{"Finance", "Sales"},
+			matches:  map[string]bool{"Finance": true, "Sales": true, "Sales EMEA": false},
+		},
+		{
+			patterns: Patterns{"Sales", "fct_*"},
+			within:   nil,
+			matches:  map[string]bool{"Sales": true, "fct_orders": true, "dim_fct_orders": false},
+		},
+		{
+			patterns: Patterns{"/^(?i)stg_.+$/"},
+			within:   nil,
+			matches:  map[string]bool{"STG_ORDERS": true, "stg_": false},
+		},
+		{
+			patterns: nil,
+			within:   nil,
+			matches:  map[string]bool{"anything": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.patterns, ","), func(t *testing.T) {
+			if got := tt.patterns.Within(); !reflect.DeepEqual(got, tt.within) {
+				t.Errorf("Within() = %v, want %v", got, tt.within)
+			}
+			matcher, err := tt.patterns.Compile()
+			if err != nil {
+				t.Fatalf("Compile() error = %v", err)
+			}
+			for name, want := range tt.matches {
+				if got := matcher.Match(name); got != want {
+					t.Errorf("Match(%s) = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/getsynq/connections-tableau/filter"
+	"github.com/getsynq/connections-tableau/internal"
+	"github.com/getsynq/connections-tableau/lineage"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/getsynq/connections-tableau/output"
+	"github.com/pkg/errors"
+)
+
+// idsPerRequest bounds the number of ids sent in a single `idWithin` filter.
+const idsPerRequest = 100
+
+// crawlIncremental refetches only the workbooks, published datasources and flows updated after previous
+// was extracted, together with their sheets, dashboards, embedded datasources, upstream (and for flows
+// downstream) tables and lineage, and merges them with the unchanged parts of previous into a complete
+// snapshot. With a writer every entity is passed to it once it is merged and left out of the result.
+func crawlIncremental(ctx context.Context, client graphql.Client, perPage int, checkpoint *internal.Checkpoint, filters *filter.Options, previous *model.Response, writer output.Writer, progress io.Writer) (*model.Response, error) {
+	extractedAt := checkpoint.Started()
+	since := watermark(previous)
+	if since.IsZero() {
+		return nil, errors.New("previous export has no timestamps to continue from, run a full export first")
+	}
+	fmt.Fprintf(progress, "Fetching changes since %s\n", since.Format(time.RFC3339))
+
+	// workbooks, published datasources and flows are listed with their updatedAt only, to find what changed
+	workbookVersions, err := fetchWorkbookVersions(ctx, client, perPage, checkpoint, filters.WorkbookFilter())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list workbooks")
+	}
+	currentWorkbooks, changedWorkbookIds := map[string]bool{}, []string{}
+	previousWorkbooks := idSet(previous.Workbooks, func(workbook *metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook) string { return workbook.Id })
+	for _, workbook := range workbookVersions {
+		if !filters.AcceptsWorkbook(workbook.ProjectName, workbook.Name) {
+			continue
+		}
+		currentWorkbooks[workbook.Id] = true
+		if !previousWorkbooks[workbook.Id] || !workbook.UpdatedAt.Before(since) {
+			changedWorkbookIds = append(changedWorkbookIds, workbook.Id)
+		}
+	}
+
+	datasourceVersions, err := fetchPublishedDatasourceVersions(ctx, client, perPage, checkpoint, filters.PublishedDatasourceFilter())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list published datasources")
+	}
+	currentDatasources, changedDatasourceIds := map[string]bool{}, []string{}
+	previousDatasources := idSet(previous.PublishedDatasources, func(datasource *metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) string {
+		return datasource.Id
+	})
+	for _, datasource := range datasourceVersions {
+		if !filters.AcceptsDatasource(datasource.ProjectName, datasource.Name) {
+			continue
+		}
+		currentDatasources[datasource.Id] = true
+		if !previousDatasources[datasource.Id] || !datasource.UpdatedAt.Before(since) {
+			changedDatasourceIds = append(changedDatasourceIds, datasource.Id)
+		}
+	}
+
+	flowVersions, err := fetchFlowVersions(ctx, client, perPage, checkpoint, filters.FlowFilter())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list flows")
+	}
+	currentFlows, changedFlowIds := map[string]bool{}, []string{}
+	previousFlows := idSet(previous.Flows, func(flow *metadata.GetFlowsFlowsConnectionNodesFlow) string { return flow.Id })
+	for _, flow := range flowVersions {
+		if !filters.AcceptsFlow(flow.ProjectName) {
+			continue
+		}
+		currentFlows[flow.Id] = true
+		if !previousFlows[flow.Id] || !flow.UpdatedAt.Before(since) {
+			changedFlowIds = append(changedFlowIds, flow.Id)
+		}
+	}
+
+	fmt.Fprintf(progress, "Found %d changed workbooks, %d changed published datasources and %d changed flows\n", len(changedWorkbookIds), len(changedDatasourceIds), len(changedFlowIds))
+
+	refetchedWorkbooks, err := fetchByIds(changedWorkbookIds, checkpoint, "workbooks", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook, error) {
+		workbookFilter := filters.WorkbookFilter().WithIds(ids)
+		return fetchWorkbooks(ctx, client, perPage, checkpoint, workbookFilter, nil)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain workbooks")
+	}
+	changedWorkbooks := map[string]bool{}
+	var sheetIds, dashboardIds, embeddedDatasourceIds, upstreamTableIds []string
+	for _, workbook := range refetchedWorkbooks {
+		changedWorkbooks[workbook.Id] = true
+		for _, sheet := range workbook.Sheets {
+			sheetIds = append(sheetIds, sheet.Id)
+		}
+		for _, dashboard := range workbook.Dashboards {
+			dashboardIds = append(dashboardIds, dashboard.Id)
+		}
+		for _, datasource := range workbook.EmbeddedDatasources {
+			embeddedDatasourceIds = append(embeddedDatasourceIds, datasource.Id)
+		}
+		for _, table := range workbook.UpstreamTables {
+			upstreamTableIds = append(upstreamTableIds, table.Id)
+		}
+	}
+	// unchanged workbooks keep their sheets, dashboards and embedded datasources from the previous export
+	unchangedWorkbook := func(workbookId string) bool {
+		return currentWorkbooks[workbookId] && !changedWorkbooks[workbookId]
+	}
+
+	refetchedPublishedDatasources, err := fetchByIds(changedDatasourceIds, checkpoint, "publishedDatasources", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource, error) {
+		publishedDatasourceFilter := filters.PublishedDatasourceFilter().WithIds(ids)
+		return fetchPublishedDatasources(ctx, client, perPage, checkpoint, publishedDatasourceFilter, nil)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain published datasources")
+	}
+	changedDatasources := map[string]bool{}
+	var calculatedFieldIds []string
+	for _, datasource := range refetchedPublishedDatasources {
+		changedDatasources[datasource.Id] = true
+		for _, table := range datasource.UpstreamTables {
+			upstreamTableIds = append(upstreamTableIds, table.Id)
+		}
+		calculatedFieldIds = append(calculatedFieldIds, calculatedFields(datasource.Fields)...)
+	}
+
+	refetchedFlows, err := fetchByIds(changedFlowIds, checkpoint, "flows", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetFlowsFlowsConnectionNodesFlow, error) {
+		return fetchFlows(ctx, client, perPage, checkpoint, filters.FlowFilter().WithIds(ids), nil)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain flows")
+	}
+	changedFlows := map[string]bool{}
+	for _, flow := range refetchedFlows {
+		changedFlows[flow.Id] = true
+		// the tables a flow writes change with it as well as those it reads
+		for _, table := range flow.UpstreamTables {
+			upstreamTableIds = append(upstreamTableIds, table.Id)
+		}
+		for _, table := range flow.DownstreamTables {
+			upstreamTableIds = append(upstreamTableIds, table.Id)
+		}
+	}
+
+	refetchedEmbeddedDatasources, err := fetchByIds(embeddedDatasourceIds, checkpoint, "embeddedDatasources", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource, error) {
+		embeddedDatasourceFilter := filters.EmbeddedDatasourceFilter().WithIds(ids)
+		return fetchEmbeddedDatasources(ctx, client, perPage, checkpoint, embeddedDatasourceFilter, nil)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain embedded datasources")
+	}
+	for _, datasource := range refetchedEmbeddedDatasources {
+		changedDatasources[datasource.Id] = true
+		for _, table := range datasource.UpstreamTables {
+			upstreamTableIds = append(upstreamTableIds, table.Id)
+		}
+		calculatedFieldIds = append(calculatedFieldIds, calculatedFields(datasource.Fields)...)
+	}
+
+	publishedDatasources := acceptPublishedDatasources(append(filterNodes(previous.PublishedDatasources, func(datasource *metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) bool {
+		return currentDatasources[datasource.Id] && !changedDatasources[datasource.Id]
+	}), refetchedPublishedDatasources...), filters)
+
+	fmt.Fprintf(progress, "Discovered %d published datasources\n", len(publishedDatasources))
+	if publishedDatasources, err = flush(writer, "publishedDatasources", publishedDatasources); err != nil {
+		return nil, err
+	}
+
+	embeddedDatasources := acceptEmbeddedDatasources(append(filterNodes(previous.EmbeddedDatasources, func(datasource *metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) bool {
+		return unchangedWorkbook(datasource.Workbook.Id)
+	}), refetchedEmbeddedDatasources...), filters)
+
+	fmt.Fprintf(progress, "Discovered %d embedded datasources\n", len(embeddedDatasources))
+	currentEmbeddedDatasources := idSet(embeddedDatasources, func(datasource *metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) string {
+		return datasource.Id
+	})
+	if embeddedDatasources, err = flush(writer, "embeddedDatasources", embeddedDatasources); err != nil {
+		return nil, err
+	}
+
+	workbooks := acceptWorkbooks(append(filterNodes(previous.Workbooks, func(workbook *metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook) bool {
+		return unchangedWorkbook(workbook.Id)
+	}), refetchedWorkbooks...), filters)
+
+	fmt.Fprintf(progress, "Discovered %d workbooks\n", len(workbooks))
+	if workbooks, err = flush(writer, "workbooks", workbooks); err != nil {
+		return nil, err
+	}
+
+	refetchedSheets, err := fetchByIds(sheetIds, checkpoint, "sheets", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetSheetsSheetsConnectionNodesSheet, error) {
+		return fetchSheets(ctx, client, perPage, checkpoint, &filter.Sheet{IdWithin: ids}, nil)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain sheets")
+	}
+	sheets := acceptSheets(append(filterNodes(previous.Sheets, func(sheet *metadata.GetSheetsSheetsConnectionNodesSheet) bool {
+		return unchangedWorkbook(sheet.Workbook.Id)
+	}), refetchedSheets...), filters)
+
+	fmt.Fprintf(progress, "Discovered %d sheets\n", len(sheets))
+
+	// sheet lineage is refetched for the sheets of changed workbooks and those using changed datasources
+	lineageSheetIds := append([]string{}, sheetIds...)
+	for _, sheet := range sheets {
+		if changedWorkbooks[sheet.Workbook.Id] {
+			continue
+		}
+		for _, datasource := range sheet.UpstreamDatasources {
+			if changedDatasources[datasource.GetId()] {
+				lineageSheetIds = append(lineageSheetIds, sheet.Id)
+				break
+			}
+		}
+	}
+	if sheets, err = flush(writer, "sheets", sheets); err != nil {
+		return nil, err
+	}
+
+	refetchedDashboards, err := fetchByIds(dashboardIds, checkpoint, "dashboards", func(ids []string, checkpoint *internal.Checkpoint) ([]metadata.GetDashboardsDashboardsConnectionNodesDashboard, error) {
+		return fetchDashboards(ctx, client, perPage, checkpoint, &filter.Dashboard{IdWithin: ids}, nil)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain dashboards")
+	}
+	dashboards := acceptDashboards(append(filterNodes(previous.Dashboards, func(dashboard *metadata.GetDashboardsDashboardsConnectionNodesDashboard) bool {
+		return unchangedWorkbook(dashboard.Workbook.Id)
+	}), refetchedDashboards...), filters)
+
+	fmt.Fprintf(progress, "Discovered %d dashboards\n", len(dashboards))
+	if dashboards, err = flush(writer, "dashboards", dashboards); err != nil {
+		return nil, err
+	}
+
+	flows := acceptFlows(append(filterNodes(previous.Flows, func(flow *metadata.GetFlowsFlowsConnectionNodesFlow) bool {
+		return currentFlows[flow.Id] && !changedFlows[flow.Id]
+	}), refetchedFlows...), filters)
+
+	fmt.Fprintf(progress, "Discovered %d flows\n", len(flows))
+	if flows, err = flush(writer, "flows", flows); err != nil {
+		return nil, err
+	}
+
+	// tables have no timestamps, their definitions are cheap compared with lineage and refetched on every run
+	// so that changed columns, types and descriptions get through
+	tableNodes, err := fetchDatabaseTables(ctx, client, perPage, checkpoint, filters.DatabaseTableFilter(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain metadata")
+	}
+	databaseTables := acceptDatabaseTables(tableNodes, filters)
+	currentTables := map[string]bool{}
+	currentColumns := map[string]bool{}
+	for _, table := range databaseTables {
+		currentTables[table.Id] = true
+		for _, column := range table.Columns {
+			currentColumns[column.Id] = true
+		}
+	}
+	previousColumns := map[string]bool{}
+	for _, table := range previous.DatabaseTables {
+		for _, column := range table.Columns {
+			previousColumns[column.Id] = true
+		}
+	}
+	// lineage is refetched for new columns and the columns of tables upstream of changed content
+	upstreamTables := toSet(upstreamTableIds)
+	var columnIds []string
+	for _, table := range databaseTables {
+		for _, column := range table.Columns {
+			if upstreamTables[table.Id] || !previousColumns[column.Id] {
+				columnIds = append(columnIds, column.Id)
+			}
+		}
+	}
+
+	fmt.Fprintf(progress, "Discovered %d database tables\n", len(databaseTables))
+	if databaseTables, err = flush(writer, "databaseTables", databaseTables); err != nil {
+		return nil, err
+	}
+
+	customSQLTables, customSQLReferences, customSQLColumnIds, err := crawlCustomSQLTables(ctx, client, perPage, checkpoint, filters, writer, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	// lineage is refetched for the columns found above and all custom SQL tables, the calculated
+	// fields of changed datasources and the sheets found above
+	columnIds = append(columnIds, customSQLColumnIds...)
+	builder := lineage.NewBuilder()
+	if err := crawlLineage(ctx, client, perPage, checkpoint, columnIds, calculatedFieldIds, lineageSheetIds, builder); err != nil {
+		return nil, err
+	}
+
+	refetchedColumns := toSet(columnIds)
+	refetchedCalculatedFields := toSet(calculatedFieldIds)
+	refetchedSheetIds := toSet(lineageSheetIds)
+	// edges into changed workbooks and datasources were all refetched above, besides those only edges of
+	// content which no longer exists are dropped
+	removed := func(node lineage.Node) bool {
+		switch node.ParentType {
+		case "Workbook":
+			return !currentWorkbooks[node.ParentId]
+		case "PublishedDatasource":
+			return !currentDatasources[node.ParentId]
+		case "EmbeddedDatasource":
+			return !currentEmbeddedDatasources[node.ParentId]
+		case "DatabaseTable":
+			return !currentTables[node.ParentId] || !currentColumns[node.Id]
+		}
+		return false
+	}
+	columnLineage := lineage.Merge(previous.ColumnLineage, func(edge lineage.Edge) bool {
+		return refetchedColumns[edge.Source.Id] || refetchedCalculatedFields[edge.Target.Id] || refetchedSheetIds[edge.Target.Id] ||
+			changedWorkbooks[edge.Target.ParentId] || changedDatasources[edge.Target.ParentId] ||
+			removed(edge.Source) || removed(edge.Target)
+	}, builder.Edges())
+
+	fmt.Fprintf(progress, "Discovered %d column lineage edges\n", len(columnLineage))
+
+	return &model.Response{
+		ExtractedAt:          extractedAt,
+		DatabaseTables:       databaseTables,
+		CustomSQLTables:      customSQLTables,
+		CustomSQLReferences:  customSQLReferences,
+		PublishedDatasources: publishedDatasources,
+		EmbeddedDatasources:  embeddedDatasources,
+		Workbooks:            workbooks,
+		Sheets:               sheets,
+		Dashboards:           dashboards,
+		Flows:                flows,
+		ColumnLineage:        columnLineage,
+	}, nil
+}
+
+// watermark is when previous was extracted, for exports without it the latest updatedAt they contain.
+func watermark(previous *model.Response) time.Time {
+	if !previous.ExtractedAt.IsZero() {
+		return previous.ExtractedAt
+	}
+	var latest time.Time
+	for _, workbook := range previous.Workbooks {
+		if workbook.UpdatedAt.After(latest) {
+			latest = workbook.UpdatedAt
+		}
+	}
+	for _, datasource := range previous.PublishedDatasources {
+		if datasource.UpdatedAt.After(latest) {
+			latest = datasource.UpdatedAt
+		}
+	}
+	for _, flow := range previous.Flows {
+		if flow.UpdatedAt.After(latest) {
+			latest = flow.UpdatedAt
+		}
+	}
+	return latest
+}
+
+// fetchByIds fetches the nodes with the given ids in batches, each batch checkpointed on its own.
+func fetchByIds[T any](ids []string, checkpoint *internal.Checkpoint, name string, fetch func(ids []string, checkpoint *internal.Checkpoint) ([]T, error)) ([]T, error) {
+	nodes := make([]T, 0, len(ids))
+	for i := 0; i < len(ids); i += idsPerRequest {
+		end := i + idsPerRequest
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch, err := fetch(ids[i:end], checkpoint.Scope(fmt.Sprintf("%s-%d-", name, i/idsPerRequest)))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, batch...)
+	}
+	return nodes, nil
+}
+
+func idSet[T any](nodes []T, id func(node *T) string) map[string]bool {
+	ids := make(map[string]bool, len(nodes))
+	for i := range nodes {
+		ids[id(&nodes[i])] = true
+	}
+	return ids
+}
+
+func toSet(ids []string) map[string]bool {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
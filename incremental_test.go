@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/getsynq/connections-tableau/filter"
+	"github.com/getsynq/connections-tableau/lineage"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// fakeClient answers every query with the nodes registered for its connection, ignoring variables.
+type fakeClient map[string]string
+
+func (c fakeClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	connections := map[string]string{
+		"GetWorkbookVersions":            "workbooksConnection",
+		"GetWorkbooks":                   "workbooksConnection",
+		"GetPublishedDatasourceVersions": "publishedDatasourcesConnection",
+		"GetPublishedDatasources":        "publishedDatasourcesConnection",
+		"GetEmbeddedDatasources":         "embeddedDatasourcesConnection",
+		"GetSheets":                      "sheetsConnection",
+		"GetDashboards":                  "dashboardsConnection",
+		"GetFlowVersions":                "flowsConnection",
+		"GetFlows":                       "flowsConnection",
+		"GetDatabaseTablesDefinitions":   "databaseTablesConnection",
+		"GetDatabaseTableCounts":         "databasesConnection",
+		"GetCustomSQLTablesDefinitions":  "customSQLTablesConnection",
+		"GetColumnLineage":               "columnsConnection",
+		"GetCalculatedFieldLineage":      "calculatedFieldsConnection",
+		"GetSheetFieldLineage":           "sheetsConnection",
+	}
+	nodes, ok := c[req.OpName]
+	if !ok {
+		nodes = "[]"
+	}
+	data := fmt.Sprintf(`{"%s": {"nodes": %s, "pageInfo": {"hasNextPage": false, "endCursor": ""}, "totalCount": 0}}`, connections[req.OpName], nodes)
+	return json.Unmarshal([]byte(data), resp.Data)
+}
+
+func TestCrawlIncremental(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	previous := &model.Response{
+		ExtractedAt: since,
+		Workbooks: []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook{
+			{Id: "unchanged", Name: "Unchanged"},
+			{Id: "changed", Name: "Changed"},
+			{Id: "deleted", Name: "Deleted"},
+		},
+		Sheets: []metadata.GetSheetsSheetsConnectionNodesSheet{
+			{Id: "unchanged-sheet", Workbook: metadata.GetSheetsSheetsConnectionNodesSheetWorkbook{Id: "unchanged"}},
+			{Id: "changed-sheet", Name: "Old", Workbook: metadata.GetSheetsSheetsConnectionNodesSheetWorkbook{Id: "changed"}},
+			{Id: "deleted-sheet", Workbook: metadata.GetSheetsSheetsConnectionNodesSheetWorkbook{Id: "deleted"}},
+		},
+		ColumnLineage: []lineage.Edge{
+			{Source: lineage.Node{Id: "field"}, Target: lineage.Node{Id: "unchanged-sheet", ParentId: "unchanged", ParentType: "Workbook"}},
+			{Source: lineage.Node{Id: "field"}, Target: lineage.Node{Id: "changed-sheet", ParentId: "changed", ParentType: "Workbook"}},
+			{Source: lineage.Node{Id: "field"}, Target: lineage.Node{Id: "deleted-sheet", ParentId: "deleted", ParentType: "Workbook"}},
+		},
+	}
+	client := fakeClient{
+		"GetWorkbookVersions": `[
+			{"id": "unchanged", "name": "Unchanged", "updatedAt": "2022-12-01T00:00:00Z"},
+			{"id": "changed", "name": "Changed", "updatedAt": "2023-01-02T00:00:00Z"}
+		]`,
+		"GetWorkbooks": `[{"id": "changed", "name": "Changed", "sheets": [{"id": "changed-sheet"}]}]`,
+		"GetSheets":    `[{"id": "changed-sheet", "name": "New", "workbook": {"id": "changed"}}]`,
+	}
+
+	filters := &filter.Options{}
+	if err := filters.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := crawlIncremental(context.Background(), client, 100, nil, filters, previous, nil, io.Discard)
+	if err != nil {
+		t.Fatalf("crawlIncremental() error = %v", err)
+	}
+
+	var workbooks, sheets []string
+	for _, workbook := range got.Workbooks {
+		workbooks = append(workbooks, workbook.Id)
+	}
+	for _, sheet := range got.Sheets {
+		sheets = append(sheets, sheet.Id+"/"+sheet.Name)
+	}
+	if want := []string{"unchanged", "changed"}; !reflect.DeepEqual(workbooks, want) {
+		t.Errorf("crawlIncremental() workbooks = %v, want %v", workbooks, want)
+	}
+	if want := []string{"unchanged-sheet/", "changed-sheet/New"}; !reflect.DeepEqual(sheets, want) {
+		t.Errorf("crawlIncremental() sheets = %v, want %v", sheets, want)
+	}
+	if len(got.ColumnLineage) != 1 || got.ColumnLineage[0].Target.Id != "unchanged-sheet" {
+		t.Errorf("crawlIncremental() lineage = %v, want only the edge of the unchanged sheet", got.ColumnLineage)
+	}
+	if got.ExtractedAt.Before(since) {
+		t.Errorf("crawlIncremental() extractedAt = %v, want a new watermark", got.ExtractedAt)
+	}
+}
+
+func TestCrawlIncrementalFlows(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	previous := &model.Response{
+		ExtractedAt: since,
+		DatabaseTables: model.DatabaseTables(
+			&metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{Id: "output", Name: "Old"},
+		),
+		Flows: []metadata.GetFlowsFlowsConnectionNodesFlow{
+			{Id: "unchanged", Name: "Unchanged"},
+			{Id: "changed", Name: "Old"},
+			{Id: "deleted", Name: "Deleted"},
+		},
+	}
+	client := fakeClient{
+		"GetFlowVersions": `[
+			{"id": "unchanged", "updatedAt": "2022-12-01T00:00:00Z"},
+			{"id": "changed", "updatedAt": "2023-01-02T00:00:00Z"}
+		]`,
+		"GetFlows":                     `[{"id": "changed", "name": "New", "downstreamTables": [{"id": "output"}]}]`,
+		"GetDatabaseTablesDefinitions": `[{"id": "output", "name": "New"}]`,
+	}
+
+	filters := &filter.Options{}
+	if err := filters.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := crawlIncremental(context.Background(), client, 100, nil, filters, previous, nil, io.Discard)
+	if err != nil {
+		t.Fatalf("crawlIncremental() error = %v", err)
+	}
+
+	var flows, tables []string
+	for _, flow := range got.Flows {
+		flows = append(flows, flow.Id+"/"+flow.Name)
+	}
+	for _, table := range got.DatabaseTables {
+		tables = append(tables, table.Id+"/"+table.Name)
+	}
+	if want := []string{"unchanged/Unchanged", "changed/New"}; !reflect.DeepEqual(flows, want) {
+		t.Errorf("crawlIncremental() flows = %v, want %v", flows, want)
+	}
+	if want := []string{"output/New"}; !reflect.DeepEqual(tables, want) {
+		t.Errorf("crawlIncremental() tables = %v, want %v", tables, want)
+	}
+}
+
+func TestCrawlIncrementalTables(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	table := lineage.Node{Id: "orders", Type: "DatabaseTable"}
+	previous := &model.Response{
+		ExtractedAt: since,
+		DatabaseTables: model.DatabaseTables(
+			&metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{
+				Id: "orders", Name: "orders", Description: "Old",
+				Columns: []metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn{
+					{Id: "id", Name: "id", RemoteType: metadata.RemoteTypeI4},
+					{Id: "removed", Name: "removed", RemoteType: metadata.RemoteTypeWstr},
+				},
+			},
+			&metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{Id: "deleted", Name: "deleted"},
+		),
+		ColumnLineage: []lineage.Edge{
+			{Source: lineage.Node{Id: "id", ParentId: table.Id, ParentType: table.Type}, Target: lineage.Node{Id: "id-field"}},
+			{Source: lineage.Node{Id: "removed", ParentId: table.Id, ParentType: table.Type}, Target: lineage.Node{Id: "removed-field"}},
+		},
+	}
+	// no workbook, datasource or flow changed, the table definitions are refetched anyway
+	client := fakeClient{
+		"GetDatabaseTablesDefinitions": `[{"id": "orders", "name": "orders", "description": "New", "columns": [
+			{"id": "id", "name": "id", "remoteType": "I8"},
+			{"id": "added", "name": "added", "remoteType": "WSTR"}
+		]}]`,
+		"GetColumnLineage": `[{"id": "added", "name": "added", "table": {"__typename": "DatabaseTable", "id": "orders", "name": "orders"},
+			"referencedByFields": [{"__typename": "ColumnField", "id": "added-field", "name": "added"}]}]`,
+	}
+
+	filters := &filter.Options{}
+	if err := filters.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := crawlIncremental(context.Background(), client, 100, nil, filters, previous, nil, io.Discard)
+	if err != nil {
+		t.Fatalf("crawlIncremental() error = %v", err)
+	}
+
+	var tables []string
+	for _, table := range got.DatabaseTables {
+		tables = append(tables, table.Id+"/"+table.Description)
+		for _, column := range table.Columns {
+			tables = append(tables, column.Id+"/"+string(column.RemoteType))
+		}
+	}
+	// changed descriptions and types, added and removed columns and deleted tables all get through
+	if want := []string{"orders/New", "id/I8", "added/WSTR"}; !reflect.DeepEqual(tables, want) {
+		t.Errorf("crawlIncremental() tables = %v, want %v", tables, want)
+	}
+	// lineage of unchanged columns is kept, that of added columns is fetched and that of removed ones dropped
+	var edges []string
+	for _, edge := range got.ColumnLineage {
+		edges = append(edges, edge.Source.Id+"->"+edge.Target.Id)
+	}
+	if want := []string{"id->id-field", "added->added-field"}; !reflect.DeepEqual(edges, want) {
+		t.Errorf("crawlIncremental() lineage = %v, want %v", edges, want)
+	}
+}
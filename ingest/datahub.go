@@ -0,0 +1,189 @@
+package ingest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// MCP is a DataHub metadata change proposal in the JSON format read by `datahub ingest` from a file.
+type MCP struct {
+	EntityType string `json:"entityType"`
+	EntityUrn  string `json:"entityUrn"`
+	ChangeType string `json:"changeType"`
+	AspectName string `json:"aspectName"`
+	Aspect     Aspect `json:"aspect"`
+}
+
+type Aspect struct {
+	Json interface{} `json:"json"`
+}
+
+func DatasetUrn(table Table) string {
+	return fmt.Sprintf("urn:li:dataset:(urn:li:dataPlatform:%s,%s,PROD)", table.Platform, table.QualifiedName())
+}
+
+func ChartUrn(id string) string {
+	return fmt.Sprintf("urn:li:chart:(tableau,%s)", id)
+}
+
+func DashboardUrn(id string) string {
+	return fmt.Sprintf("urn:li:dashboard:(tableau,%s)", id)
+}
+
+// ToDataHub converts the warehouse tables of response into datasets, sheets into charts and dashboards
+// into dashboards. Charts and dashboards point at the datasets they read, dashboards at their charts.
+func ToDataHub(response *model.Response, tableauUrl, site string) []MCP {
+	tables := NewTables(response)
+	mcps := make([]MCP, 0)
+	add := func(entityType, urn, aspectName string, aspect interface{}) {
+		mcps = append(mcps, MCP{EntityType: entityType, EntityUrn: urn, ChangeType: "UPSERT", AspectName: aspectName, Aspect: Aspect{Json: aspect}})
+	}
+
+	for _, node := range response.DatabaseTables {
+		table := tables.byId[node.Id]
+		urn := DatasetUrn(table)
+		add("dataset", urn, "datasetProperties", map[string]interface{}{
+			"name":             node.Name,
+			"qualifiedName":    table.QualifiedName(),
+			"description":      node.Description,
+			"customProperties": map[string]string{"tableauId": node.Id},
+		})
+		fields := make([]map[string]interface{}, 0, len(node.Columns))
+		for _, column := range node.Columns {
+			fields = append(fields, map[string]interface{}{
+				"fieldPath":      column.Name,
+				"nativeDataType": string(column.RemoteType),
+				"type":           map[string]interface{}{"type": map[string]interface{}{"com.linkedin.schema." + typeOf(column.RemoteType).dataHub: map[string]interface{}{}}},
+			})
+		}
+		add("dataset", urn, "schemaMetadata", map[string]interface{}{
+			"schemaName":     table.QualifiedName(),
+			"platform":       "urn:li:dataPlatform:" + table.Platform,
+			"version":        0,
+			"hash":           "",
+			"platformSchema": map[string]interface{}{"com.linkedin.schema.OtherSchema": map[string]string{"rawSchema": ""}},
+			"fields":         fields,
+		})
+	}
+
+	for _, sheet := range response.Sheets {
+		inputs := make([]map[string]string, 0)
+		for _, upstream := range sheet.UpstreamTables {
+			if table, ok := tables.Upstream(upstream); ok {
+				inputs = append(inputs, map[string]string{"destinationUrn": DatasetUrn(table)})
+			}
+		}
+		info := map[string]interface{}{
+			"title":        sheet.Name,
+			"description":  "",
+			"lastModified": changeAuditStamps(sheet.CreatedAt, sheet.UpdatedAt, sheet.Workbook.Owner.Username),
+			"inputEdges":   inputs,
+		}
+		if url := ViewUrl(tableauUrl, site, sheet.Path); url != "" {
+			info["chartUrl"] = url
+		}
+		add("chart", ChartUrn(key(sheet.Luid, sheet.Id)), "chartInfo", info)
+	}
+
+	for _, dashboard := range response.Dashboards {
+		charts := make([]map[string]string, 0, len(dashboard.Sheets))
+		for _, sheet := range dashboard.Sheets {
+			charts = append(charts, map[string]string{"destinationUrn": ChartUrn(key(sheet.Luid, sheet.Id))})
+		}
+		datasets := make([]map[string]string, 0)
+		for _, upstream := range dashboard.UpstreamTables {
+			if table, ok := tables.Upstream(upstream); ok {
+				datasets = append(datasets, map[string]string{"destinationUrn": DatasetUrn(table)})
+			}
+		}
+		info := map[string]interface{}{
+			"title":        dashboard.Name,
+			"description":  "",
+			"lastModified": changeAuditStamps(dashboard.CreatedAt, dashboard.UpdatedAt, dashboard.Workbook.Owner.Username),
+			"chartEdges":   charts,
+			"datasetEdges": datasets,
+		}
+		if url := ViewUrl(tableauUrl, site, dashboard.Path); url != "" {
+			info["dashboardUrl"] = url
+		}
+		add("dashboard", DashboardUrn(key(dashboard.Luid, dashboard.Id)), "dashboardInfo", info)
+	}
+
+	return mcps
+}
+
+func changeAuditStamps(createdAt, updatedAt time.Time, username string) map[string]interface{} {
+	actor := "urn:li:corpuser:unknown"
+	if username != "" {
+		actor = "urn:li:corpuser:" + username
+	}
+	return map[string]interface{}{
+		"created":      map[string]interface{}{"time": createdAt.UnixMilli(), "actor": actor},
+		"lastModified": map[string]interface{}{"time": updatedAt.UnixMilli(), "actor": actor},
+	}
+}
+
+// key prefers the LUID, which the REST API and the Tableau connectors of the catalogs use as well.
+func key(luid, id string) string {
+	if luid != "" {
+		return luid
+	}
+	return id
+}
+
+// ViewUrl is the URL of a sheet or dashboard with the given path, e.g. Superstore/Overview.
+func ViewUrl(tableauUrl, site, path string) string {
+	if path == "" {
+		return ""
+	}
+	tableauUrl = strings.TrimSuffix(tableauUrl, "/")
+	if site == "" {
+		return fmt.Sprintf("%s/#/views/%s", tableauUrl, path)
+	}
+	return fmt.Sprintf("%s/#/site/%s/views/%s", tableauUrl, site, path)
+}
+
+type columnType struct {
+	dataHub      string
+	openMetadata string
+}
+
+// remoteTypes maps the remote types of columns onto the types of the catalogs.
+var remoteTypes = map[metadata.RemoteType]columnType{
+	"I1":          {"NumberType", "TINYINT"},
+	"I2":          {"NumberType", "SMALLINT"},
+	"I4":          {"NumberType", "INT"},
+	"I8":          {"NumberType", "BIGINT"},
+	"UI1":         {"NumberType", "SMALLINT"},
+	"UI2":         {"NumberType", "INT"},
+	"UI4":         {"NumberType", "BIGINT"},
+	"UI8":         {"NumberType", "NUMERIC"},
+	"R4":          {"NumberType", "FLOAT"},
+	"R8":          {"NumberType", "DOUBLE"},
+	"CY":          {"NumberType", "NUMERIC"},
+	"DECIMAL":     {"NumberType", "DECIMAL"},
+	"NUMERIC":     {"NumberType", "NUMERIC"},
+	"VARNUMERIC":  {"NumberType", "NUMERIC"},
+	"BOOL":        {"BooleanType", "BOOLEAN"},
+	"STR":         {"StringType", "STRING"},
+	"WSTR":        {"StringType", "STRING"},
+	"BSTR":        {"StringType", "STRING"},
+	"GUID":        {"StringType", "UUID"},
+	"DATE":        {"DateType", "DATE"},
+	"DBDATE":      {"DateType", "DATE"},
+	"DBTIME":      {"TimeType", "TIME"},
+	"DBTIMESTAMP": {"TimeType", "TIMESTAMP"},
+	"FILETIME":    {"TimeType", "TIMESTAMP"},
+	"BYTES":       {"BytesType", "BYTES"},
+}
+
+func typeOf(remoteType metadata.RemoteType) columnType {
+	if t, ok := remoteTypes[remoteType]; ok {
+		return t
+	}
+	return columnType{dataHub: "NullType", openMetadata: "UNKNOWN"}
+}
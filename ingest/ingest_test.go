@@ -0,0 +1,138 @@
+package ingest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/internal/fixture"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+)
+
+func TestTableFromFullName(t *testing.T) {
+	tests := []struct {
+		connectionType string
+		database       string
+		fullName       string
+		want           Table
+	}{
+		{"bigquery", "", "[my-project].[dataset].[orders]", Table{"bigquery", "my-project", "dataset", "orders"}},
+		{"snowflake", "ANALYTICS", `"ANALYTICS"."PUBLIC"."ORDERS"`, Table{"snowflake", "ANALYTICS", "PUBLIC", "ORDERS"}},
+		// unquoted names are case folded like the warehouse does
+		{"snowflake", "analytics", "[public].[orders]", Table{"snowflake", "ANALYTICS", "PUBLIC", "ORDERS"}},
+		{"redshift", "DEV", "[Public].[Orders]", Table{"redshift", "dev", "public", "orders"}},
+		{"redshift", "dev", "[public].[orders]", Table{"redshift", "dev", "public", "orders"}},
+		{"sqlserver", "sales", "[dbo].[order.lines]", Table{"mssql", "sales", "dbo", "order.lines"}},
+		{"clickhouse", "default", "events", Table{"clickhouse", "default", "", "events"}},
+		{"bigquery", "", "`a``b`.c", Table{"bigquery", "", "a`b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fullName, func(t *testing.T) {
+			if got := TableFromFullName(tt.connectionType, tt.database, tt.fullName); got != tt.want {
+				t.Errorf("TableFromFullName() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// export adds a column of a type which is unknown to the catalogs to the fixture.
+func export(t *testing.T) *model.Response {
+	response := fixture.Export(t)
+	table := response.DatabaseTables[0]
+	table.Columns = append(table.Columns, metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn{Id: "c3", Name: "blob", RemoteType: "SOMETHING"})
+	return response
+}
+
+func TestToDataHub(t *testing.T) {
+	mcps := ToDataHub(export(t), "https://tableau.example.com/", "finance")
+
+	got := make([]string, 0, len(mcps))
+	for _, mcp := range mcps {
+		got = append(got, mcp.EntityUrn+" "+mcp.AspectName)
+	}
+	want := []string{
+		"urn:li:dataset:(urn:li:dataPlatform:snowflake,ANALYTICS.PUBLIC.ORDERS,PROD) datasetProperties",
+		"urn:li:dataset:(urn:li:dataPlatform:snowflake,ANALYTICS.PUBLIC.ORDERS,PROD) schemaMetadata",
+		"urn:li:chart:(tableau,sheet-luid) chartInfo",
+		"urn:li:dashboard:(tableau,d1) dashboardInfo",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToDataHub() = %v, want %v", got, want)
+	}
+
+	fields := mcps[1].Aspect.Json.(map[string]interface{})["fields"].([]map[string]interface{})
+	if fields[2]["type"].(map[string]interface{})["type"].(map[string]interface{})["com.linkedin.schema.NullType"] == nil {
+		t.Errorf("unknown remote type = %v, want NullType", fields[2]["type"])
+	}
+
+	chart := mcps[2].Aspect.Json.(map[string]interface{})
+	if chart["chartUrl"] != "https://tableau.example.com/#/site/finance/views/Sales/Revenue" {
+		t.Errorf("chartUrl = %v", chart["chartUrl"])
+	}
+	wantInputs := []map[string]string{{"destinationUrn": "urn:li:dataset:(urn:li:dataPlatform:snowflake,ANALYTICS.PUBLIC.ORDERS,PROD)"}}
+	if !reflect.DeepEqual(chart["inputEdges"], wantInputs) {
+		t.Errorf("inputEdges = %v, want %v", chart["inputEdges"], wantInputs)
+	}
+	if actor := chart["lastModified"].(map[string]interface{})["created"].(map[string]interface{})["actor"]; actor != "urn:li:corpuser:jo" {
+		t.Errorf("actor = %v", actor)
+	}
+
+	dashboard := mcps[3].Aspect.Json.(map[string]interface{})
+	wantCharts := []map[string]string{{"destinationUrn": "urn:li:chart:(tableau,sheet-luid)"}}
+	if !reflect.DeepEqual(dashboard["chartEdges"], wantCharts) {
+		t.Errorf("chartEdges = %v, want %v", dashboard["chartEdges"], wantCharts)
+	}
+	// the custom SQL table is left out and the database of the table missing from the export is unknown
+	wantDatasets := []map[string]string{
+		{"destinationUrn": "urn:li:dataset:(urn:li:dataPlatform:snowflake,ANALYTICS.PUBLIC.ORDERS,PROD)"},
+		{"destinationUrn": "urn:li:dataset:(urn:li:dataPlatform:snowflake,PUBLIC.CUSTOMERS,PROD)"},
+	}
+	if !reflect.DeepEqual(dashboard["datasetEdges"], wantDatasets) {
+		t.Errorf("datasetEdges = %v, want %v", dashboard["datasetEdges"], wantDatasets)
+	}
+}
+
+func TestToOpenMetadata(t *testing.T) {
+	got := ToOpenMetadata(export(t), "https://tableau.example.com", "")
+
+	wantTables := []CreateTable{{
+		Name: "ORDERS", TableType: "Regular", DatabaseSchema: "snowflake.ANALYTICS.PUBLIC",
+		Columns: []CreateColumn{
+			{Name: "id", DataType: "BIGINT", DataTypeDisplay: "I8"},
+			{Name: "note", DataType: "STRING", DataTypeDisplay: "WSTR"},
+			{Name: "blob", DataType: "UNKNOWN", DataTypeDisplay: "SOMETHING"},
+		},
+	}}
+	if !reflect.DeepEqual(got.Tables, wantTables) {
+		t.Errorf("Tables = %+v, want %+v", got.Tables, wantTables)
+	}
+	wantCharts := []CreateChart{{Name: "sheet-luid", DisplayName: "Revenue", ChartType: "Other", SourceUrl: "https://tableau.example.com/#/views/Sales/Revenue", Service: "tableau"}}
+	if !reflect.DeepEqual(got.Charts, wantCharts) {
+		t.Errorf("Charts = %+v, want %+v", got.Charts, wantCharts)
+	}
+	wantDashboards := []CreateDashboard{{Name: "d1", DisplayName: "Overview", SourceUrl: "https://tableau.example.com/#/views/Sales/Overview", Project: "Finance", Charts: []string{"tableau.sheet-luid"}, Service: "tableau"}}
+	if !reflect.DeepEqual(got.Dashboards, wantDashboards) {
+		t.Errorf("Dashboards = %+v, want %+v", got.Dashboards, wantDashboards)
+	}
+	wantLineage := []AddLineage{
+		{Edge: LineageEdge{
+			FromEntity: EntityReference{Type: "table", FullyQualifiedName: "snowflake.ANALYTICS.PUBLIC.ORDERS"},
+			ToEntity:   EntityReference{Type: "chart", FullyQualifiedName: "tableau.sheet-luid"},
+		}},
+		{Edge: LineageEdge{
+			FromEntity: EntityReference{Type: "chart", FullyQualifiedName: "tableau.sheet-luid"},
+			ToEntity:   EntityReference{Type: "dashboard", FullyQualifiedName: "tableau.d1"},
+		}},
+		{Edge: LineageEdge{
+			FromEntity: EntityReference{Type: "table", FullyQualifiedName: "snowflake.ANALYTICS.PUBLIC.ORDERS"},
+			ToEntity:   EntityReference{Type: "dashboard", FullyQualifiedName: "tableau.d1"},
+		}},
+		{Edge: LineageEdge{
+			FromEntity: EntityReference{Type: "table", FullyQualifiedName: "snowflake.default.PUBLIC.CUSTOMERS"},
+			ToEntity:   EntityReference{Type: "dashboard", FullyQualifiedName: "tableau.d1"},
+		}},
+	}
+	if !reflect.DeepEqual(got.Lineage, wantLineage) {
+		t.Errorf("Lineage = %+v, want %+v", got.Lineage, wantLineage)
+	}
+}
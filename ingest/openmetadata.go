@@ -0,0 +1,172 @@
+package ingest
+
+import (
+	"strings"
+
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// DashboardService is the name of the OpenMetadata dashboard service charts and dashboards are created in.
+const DashboardService = "tableau"
+
+// OpenMetadata holds the create requests of the OpenMetadata API for the content of an export.
+// Entities reference each other by fully qualified name, warehouse tables live in a database service
+// named after their platform.
+type OpenMetadata struct {
+	Tables     []CreateTable     `json:"tables"`
+	Charts     []CreateChart     `json:"charts"`
+	Dashboards []CreateDashboard `json:"dashboards"`
+	Lineage    []AddLineage      `json:"lineage"`
+}
+
+type CreateTable struct {
+	Name           string         `json:"name"`
+	Description    string         `json:"description,omitempty"`
+	TableType      string         `json:"tableType"`
+	DatabaseSchema string         `json:"databaseSchema"`
+	Columns        []CreateColumn `json:"columns"`
+}
+
+type CreateColumn struct {
+	Name            string `json:"name"`
+	DataType        string `json:"dataType"`
+	DataTypeDisplay string `json:"dataTypeDisplay"`
+}
+
+type CreateChart struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	ChartType   string `json:"chartType"`
+	SourceUrl   string `json:"sourceUrl,omitempty"`
+	Service     string `json:"service"`
+}
+
+type CreateDashboard struct {
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName"`
+	SourceUrl   string   `json:"sourceUrl,omitempty"`
+	Project     string   `json:"project,omitempty"`
+	Charts      []string `json:"charts"`
+	Service     string   `json:"service"`
+}
+
+type AddLineage struct {
+	Edge LineageEdge `json:"edge"`
+}
+
+type LineageEdge struct {
+	FromEntity EntityReference `json:"fromEntity"`
+	ToEntity   EntityReference `json:"toEntity"`
+}
+
+type EntityReference struct {
+	Type               string `json:"type"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// TableFQN is the fully qualified name of a warehouse table, tables without a database or schema
+// are placed in one called default as OpenMetadata requires both.
+func TableFQN(table Table) string {
+	return FQN(table.Platform, orDefault(table.Database), orDefault(table.Schema), table.Name)
+}
+
+// FQN joins the parts of a fully qualified name, quoting the ones which contain a dot.
+func FQN(parts ...string) string {
+	quoted := make([]string, len(parts))
+	for i, part := range parts {
+		if strings.Contains(part, ".") {
+			part = `"` + part + `"`
+		}
+		quoted[i] = part
+	}
+	return strings.Join(quoted, ".")
+}
+
+func orDefault(name string) string {
+	if name == "" {
+		return "default"
+	}
+	return name
+}
+
+// ToOpenMetadata converts the warehouse tables of response into tables, sheets into charts and
+// dashboards into dashboards. Lineage goes from tables to the charts and dashboards reading them and
+// from charts to the dashboards they are on.
+func ToOpenMetadata(response *model.Response, tableauUrl, site string) *OpenMetadata {
+	tables := NewTables(response)
+	result := &OpenMetadata{Tables: []CreateTable{}, Charts: []CreateChart{}, Dashboards: []CreateDashboard{}, Lineage: []AddLineage{}}
+
+	for _, node := range response.DatabaseTables {
+		table := tables.byId[node.Id]
+		columns := make([]CreateColumn, 0, len(node.Columns))
+		for _, column := range node.Columns {
+			columns = append(columns, CreateColumn{Name: column.Name, DataType: typeOf(column.RemoteType).openMetadata, DataTypeDisplay: string(column.RemoteType)})
+		}
+		result.Tables = append(result.Tables, CreateTable{
+			Name:           table.Name,
+			Description:    node.Description,
+			TableType:      "Regular",
+			DatabaseSchema: FQN(table.Platform, orDefault(table.Database), orDefault(table.Schema)),
+			Columns:        columns,
+		})
+	}
+
+	for _, sheet := range response.Sheets {
+		name := key(sheet.Luid, sheet.Id)
+		result.Charts = append(result.Charts, CreateChart{
+			Name:        name,
+			DisplayName: sheet.Name,
+			ChartType:   "Other",
+			SourceUrl:   ViewUrl(tableauUrl, site, sheet.Path),
+			Service:     DashboardService,
+		})
+
+		seen := map[string]bool{}
+		for _, upstream := range sheet.UpstreamTables {
+			table, ok := tables.Upstream(upstream)
+			if !ok || seen[TableFQN(table)] {
+				continue
+			}
+			seen[TableFQN(table)] = true
+			result.Lineage = append(result.Lineage, AddLineage{Edge: LineageEdge{
+				FromEntity: EntityReference{Type: "table", FullyQualifiedName: TableFQN(table)},
+				ToEntity:   EntityReference{Type: "chart", FullyQualifiedName: FQN(DashboardService, name)},
+			}})
+		}
+	}
+
+	for _, dashboard := range response.Dashboards {
+		name := key(dashboard.Luid, dashboard.Id)
+		charts := make([]string, 0, len(dashboard.Sheets))
+		for _, sheet := range dashboard.Sheets {
+			charts = append(charts, FQN(DashboardService, key(sheet.Luid, sheet.Id)))
+			result.Lineage = append(result.Lineage, AddLineage{Edge: LineageEdge{
+				FromEntity: EntityReference{Type: "chart", FullyQualifiedName: FQN(DashboardService, key(sheet.Luid, sheet.Id))},
+				ToEntity:   EntityReference{Type: "dashboard", FullyQualifiedName: FQN(DashboardService, name)},
+			}})
+		}
+		result.Dashboards = append(result.Dashboards, CreateDashboard{
+			Name:        name,
+			DisplayName: dashboard.Name,
+			SourceUrl:   ViewUrl(tableauUrl, site, dashboard.Path),
+			Project:     dashboard.Workbook.ProjectName,
+			Charts:      charts,
+			Service:     DashboardService,
+		})
+
+		seen := map[string]bool{}
+		for _, upstream := range dashboard.UpstreamTables {
+			table, ok := tables.Upstream(upstream)
+			if !ok || seen[TableFQN(table)] {
+				continue
+			}
+			seen[TableFQN(table)] = true
+			result.Lineage = append(result.Lineage, AddLineage{Edge: LineageEdge{
+				FromEntity: EntityReference{Type: "table", FullyQualifiedName: TableFQN(table)},
+				ToEntity:   EntityReference{Type: "dashboard", FullyQualifiedName: FQN(DashboardService, name)},
+			}})
+		}
+	}
+
+	return result
+}
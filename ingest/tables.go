@@ -0,0 +1,87 @@
+// Package ingest converts an export into the ingestion formats of data catalogs, DataHub metadata
+// change proposals and OpenMetadata entities.
+package ingest
+
+import (
+	"strings"
+
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/getsynq/connections-tableau/warehouse"
+)
+
+// platforms maps Tableau connection types onto the platform names used by the catalogs where they differ.
+var platforms = map[string]string{
+	"sqlserver":    "mssql",
+	"azure_sql_dw": "mssql",
+}
+
+// Platform is the platform of a Tableau connection type, e.g. mssql for sqlserver.
+func Platform(connectionType string) string {
+	connectionType = strings.ToLower(connectionType)
+	if platform, ok := platforms[connectionType]; ok {
+		return platform
+	}
+	return connectionType
+}
+
+// Table is a warehouse table referenced by Tableau.
+type Table struct {
+	Platform string
+	Database string
+	Schema   string
+	Name     string
+}
+
+// TableFromFullName normalises the fullName of a table, e.g. [project].[dataset].[table] or "DB"."SCHEMA"."T",
+// into its canonical parts. database is used for names which are not qualified with one.
+func TableFromFullName(connectionType, database, fullName string) Table {
+	return tableFromIdentifier(connectionType, warehouse.Normalize(connectionType, database, fullName))
+}
+
+func tableFromIdentifier(connectionType string, id warehouse.Identifier) Table {
+	return Table{Platform: Platform(connectionType), Database: id.Database, Schema: id.Schema, Name: id.Name}
+}
+
+// QualifiedName joins database, schema and name with dots, leaving out the empty ones.
+func (t Table) QualifiedName() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{t.Database, t.Schema, t.Name} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+type databaseTable interface {
+	GetId() string
+	GetFullName() string
+	GetConnectionType() string
+}
+
+// Tables finds the warehouse tables of an export by their id.
+type Tables struct {
+	byId map[string]Table
+}
+
+// NewTables indexes the database tables of an export.
+func NewTables(response *model.Response) *Tables {
+	t := &Tables{byId: map[string]Table{}}
+	for _, table := range response.DatabaseTables {
+		t.byId[table.Id] = tableFromIdentifier(table.ConnectionType, table.Identifier.Identifier)
+	}
+	return t
+}
+
+// Upstream resolves an upstream table of a workbook, sheet or dashboard, custom SQL and virtual
+// connection tables are not warehouse tables and are left out.
+func (t *Tables) Upstream(node interface{}) (Table, bool) {
+	table, ok := node.(databaseTable)
+	if !ok {
+		return Table{}, false
+	}
+	if resolved, ok := t.byId[table.GetId()]; ok {
+		return resolved, true
+	}
+	return TableFromFullName(table.GetConnectionType(), "", table.GetFullName()), true
+}
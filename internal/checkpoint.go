@@ -0,0 +1,271 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const checkpointFile = "checkpoint.json"
+
+// Checkpoint is the progress of a crawl, written to a state directory after every page so that an
+// interrupted crawl can be resumed. Nodes of every connection are appended to <name>.ndjson while
+// checkpoint.json records how many of them and which cursor were committed.
+type Checkpoint struct {
+	dir    string
+	prefix string
+
+	StartedAt   time.Time                        `json:"startedAt"`
+	Url         string                           `json:"url"`
+	Site        string                           `json:"site"`
+	Filters     json.RawMessage                  `json:"filters"`
+	Connections map[string]*ConnectionCheckpoint `json:"connections"`
+}
+
+type ConnectionCheckpoint struct {
+	After *string `json:"after"`
+	Nodes int     `json:"nodes"`
+	Done  bool    `json:"done"`
+}
+
+// NewCheckpoint starts a fresh checkpoint in dir, discarding the state of any previous crawl. Only
+// the files of the checkpoint are removed, dir may hold other files too.
+func NewCheckpoint(dir, url, site string, filters interface{}) (*Checkpoint, error) {
+	filtersJson, err := json.Marshal(filters)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create state directory: %w", err)
+	}
+	previous := &Checkpoint{dir: dir}
+	if data, err := os.ReadFile(filepath.Join(dir, checkpointFile)); err == nil {
+		if err := json.Unmarshal(data, previous); err != nil {
+			return nil, fmt.Errorf("failed to parse previous checkpoint: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read previous checkpoint: %w", err)
+	}
+	if err := previous.removeFiles(); err != nil {
+		return nil, fmt.Errorf("failed to clear previous checkpoint: %w", err)
+	}
+	c := &Checkpoint{dir: dir, StartedAt: time.Now().UTC(), Url: url, Site: site, Filters: filtersJson, Connections: map[string]*ConnectionCheckpoint{}}
+	return c, c.save()
+}
+
+// ResumeCheckpoint loads the checkpoint in dir, failing unless it was written by a crawl of the same
+// URL and site with the same filters.
+func ResumeCheckpoint(dir, url, site string, filters interface{}) (*Checkpoint, error) {
+	filtersJson, err := json.Marshal(filters)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, checkpointFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	c := &Checkpoint{dir: dir}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint: %w", err)
+	}
+	var savedFilters bytes.Buffer
+	if err := json.Compact(&savedFilters, c.Filters); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint: %w", err)
+	}
+	switch {
+	case c.Url != url:
+		return nil, fmt.Errorf("checkpoint is for %s, not %s", c.Url, url)
+	case c.Site != site:
+		return nil, fmt.Errorf("checkpoint is for site %q, not %q", c.Site, site)
+	case !bytes.Equal(savedFilters.Bytes(), filtersJson):
+		return nil, fmt.Errorf("checkpoint was created with filters %s, not %s", savedFilters.Bytes(), filtersJson)
+	}
+	if c.Connections == nil {
+		c.Connections = map[string]*ConnectionCheckpoint{}
+	}
+	return c, nil
+}
+
+// Started is when the crawl was first started, or now without a checkpoint.
+func (c *Checkpoint) Started() time.Time {
+	if c == nil {
+		return time.Now().UTC()
+	}
+	return c.StartedAt
+}
+
+// Scope returns a view of the checkpoint whose connection names are prefixed, so the same query can be
+// checkpointed more than once in a crawl.
+func (c *Checkpoint) Scope(prefix string) *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	scoped := *c
+	scoped.prefix = c.prefix + prefix
+	return &scoped
+}
+
+// Remove deletes the files of the checkpoint once the crawl has finished, and the state directory
+// if nothing else is left in it.
+func (c *Checkpoint) Remove() error {
+	if c == nil {
+		return nil
+	}
+	if err := c.removeFiles(); err != nil {
+		return err
+	}
+	if entries, err := os.ReadDir(c.dir); err == nil && len(entries) == 0 {
+		return os.Remove(c.dir)
+	}
+	return nil
+}
+
+// removeFiles deletes checkpoint.json and the nodes file of every connection it records.
+func (c *Checkpoint) removeFiles() error {
+	files := []string{filepath.Join(c.dir, checkpointFile), filepath.Join(c.dir, checkpointFile+".tmp")}
+	for name := range c.Connections {
+		files = append(files, c.nodesFile(name))
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Checkpoint) save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(c.dir, checkpointFile+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return os.Rename(tmp, filepath.Join(c.dir, checkpointFile))
+}
+
+func (c *Checkpoint) nodesFile(name string) string {
+	return filepath.Join(c.dir, name+".ndjson")
+}
+
+// PaginateWithCheckpoint is Paginate which continues from the cursor and nodes recorded for name in
+// the checkpoint and records every page it fetches, a nil checkpoint disables both. onPage, if set, is
+// called with the nodes restored from the checkpoint and then with every fetched page, which are then
+// dropped instead of being returned so that only one page is held in memory at a time.
+func PaginateWithCheckpoint[T any](ctx context.Context, perPage int, checkpoint *Checkpoint, name string, fetch FetchPage[T], onPage func(page []T) error) ([]T, error) {
+	keep := onPage == nil
+	if checkpoint == nil {
+		return paginate(ctx, perPage, nil, keep, fetch, func(page []T, pageInfo PageInfo) error {
+			if onPage == nil {
+				return nil
+			}
+			return onPage(page)
+		})
+	}
+
+	name = checkpoint.prefix + name
+	state, ok := checkpoint.Connections[name]
+	if !ok {
+		state = &ConnectionCheckpoint{}
+		checkpoint.Connections[name] = state
+	}
+	nodes, err := readNodes[T](checkpoint.nodesFile(name), state.Nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpointed %s: %w", name, err)
+	}
+	if onPage != nil && len(nodes) > 0 {
+		if err := onPage(nodes); err != nil {
+			return nil, err
+		}
+	}
+	if state.Done {
+		return nodes, nil
+	}
+	if state.Nodes > 0 {
+		verbosef("Resuming %s after %d nodes\n", name, state.Nodes)
+	}
+
+	// rewrite the committed nodes, dropping any written after the last checkpoint
+	file, err := os.Create(checkpoint.nodesFile(name))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if err := writeNodes(file, nodes); err != nil {
+		return nil, err
+	}
+	if !keep {
+		nodes = nil
+	}
+
+	rest, err := paginate(ctx, perPage, state.After, keep, fetch, func(page []T, pageInfo PageInfo) error {
+		if onPage != nil {
+			if err := onPage(page); err != nil {
+				return err
+			}
+		}
+		if err := writeNodes(file, page); err != nil {
+			return err
+		}
+		if err := file.Sync(); err != nil {
+			return err
+		}
+		state.Nodes += len(page)
+		state.Done = !pageInfo.GetHasNextPage()
+		endCursor := pageInfo.GetEndCursor()
+		state.After = &endCursor
+		return checkpoint.save()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, rest...), nil
+}
+
+func readNodes[T any](path string, count int) ([]T, error) {
+	nodes := make([]T, 0, count)
+	if count == 0 {
+		return nodes, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 1024*1024), 256*1024*1024)
+	for len(nodes) < count && scanner.Scan() {
+		var node T
+		if err := json.Unmarshal(scanner.Bytes(), &node); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(nodes) < count {
+		return nil, errors.New("checkpoint has fewer nodes than recorded")
+	}
+	return nodes, nil
+}
+
+func writeNodes[T any](file *os.File, nodes []T) error {
+	w := bufio.NewWriter(file)
+	encoder := json.NewEncoder(w)
+	for _, node := range nodes {
+		if err := encoder.Encode(node); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPaginateWithCheckpoint(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "state")
+	filters := map[string][]string{"project": {"Finance"}}
+	items := []string{"a", "b", "c", "d", "e"}
+
+	fetch := func(failAt int) FetchPage[string] {
+		return func(ctx context.Context, first int, after *string) ([]string, PageInfo, error) {
+			offset := 0
+			if after != nil {
+				fmt.Sscanf(*after, "c%d", &offset)
+			}
+			if offset == failAt {
+				return nil, nil, errors.New("connection reset")
+			}
+			end := offset + first
+			if end > len(items) {
+				end = len(items)
+			}
+			return items[offset:end], &testPageInfo{hasNextPage: end < len(items), endCursor: fmt.Sprintf("c%d", end)}, nil
+		}
+	}
+
+	checkpoint, err := NewCheckpoint(dir, "https://tableau", "site", filters)
+	if err != nil {
+		t.Fatalf("NewCheckpoint() error = %v", err)
+	}
+	if _, err := PaginateWithCheckpoint(context.Background(), 2, checkpoint, "tables", fetch(4), nil); err == nil {
+		t.Fatalf("PaginateWithCheckpoint() expected the crawl to fail")
+	}
+
+	if _, err := ResumeCheckpoint(dir, "https://tableau", "other", filters); err == nil {
+		t.Errorf("ResumeCheckpoint() expected an error for a different site")
+	}
+	if _, err := ResumeCheckpoint(dir, "https://tableau", "site", map[string][]string{}); err == nil {
+		t.Errorf("ResumeCheckpoint() expected an error for different filters")
+	}
+
+	checkpoint, err = ResumeCheckpoint(dir, "https://tableau", "site", filters)
+	if err != nil {
+		t.Fatalf("ResumeCheckpoint() error = %v", err)
+	}
+	var firstCursor *string
+	got, err := PaginateWithCheckpoint(context.Background(), 2, checkpoint, "tables", func(ctx context.Context, first int, after *string) ([]string, PageInfo, error) {
+		if firstCursor == nil {
+			firstCursor = after
+		}
+		return fetch(-1)(ctx, first, after)
+	}, nil)
+	if err != nil {
+		t.Fatalf("PaginateWithCheckpoint() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, items) {
+		t.Errorf("PaginateWithCheckpoint() = %v, want %v", got, items)
+	}
+	if firstCursor == nil || *firstCursor != "c4" {
+		t.Errorf("PaginateWithCheckpoint() resumed from %v, want c4", firstCursor)
+	}
+
+	// a finished connection is served from the checkpoint alone
+	got, err = PaginateWithCheckpoint(context.Background(), 2, checkpoint, "tables", fetch(0), nil)
+	if err != nil || !reflect.DeepEqual(got, items) {
+		t.Errorf("PaginateWithCheckpoint() = %v, %v, want %v", got, err, items)
+	}
+}
+
+func TestCheckpointRemovesOnlyItsFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"export.json", "other.ndjson"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fetch := func(ctx context.Context, first int, after *string) ([]string, PageInfo, error) {
+		return []string{"a"}, &testPageInfo{}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		checkpoint, err := NewCheckpoint(dir, "https://tableau", "site", nil)
+		if err != nil {
+			t.Fatalf("NewCheckpoint() error = %v", err)
+		}
+		if _, err := PaginateWithCheckpoint(context.Background(), 2, checkpoint, "tables", fetch, nil); err != nil {
+			t.Fatalf("PaginateWithCheckpoint() error = %v", err)
+		}
+		if i == 1 {
+			if err := checkpoint.Remove(); err != nil {
+				t.Fatalf("Remove() error = %v", err)
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if want := []string{"export.json", "other.ndjson"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("state directory holds %v, want %v", names, want)
+	}
+}
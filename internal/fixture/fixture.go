@@ -0,0 +1,25 @@
+// Package fixture loads the export shared by the tests of the packages which read exports.
+package fixture
+
+import (
+	_ "embed"
+	"encoding/json"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/model"
+)
+
+//go:embed testdata/export.json
+var export []byte
+
+// Export is a small json export of a Snowflake table, a published datasource and a workbook with a
+// sheet and a dashboard reading it, and a custom SQL query. Every call returns a fresh copy which
+// tests may change.
+func Export(t testing.TB) *model.Response {
+	t.Helper()
+	response := &model.Response{}
+	if err := json.Unmarshal(export, response); err != nil {
+		t.Fatalf("failed to read fixture export: %v", err)
+	}
+	return response
+}
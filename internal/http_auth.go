@@ -6,20 +6,46 @@ import (
 )
 
 type authedTransport struct {
-	token   string
+	session *Session
 	wrapped http.RoundTripper
 }
 
 func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.token))
-	return t.wrapped.RoundTrip(req)
+	token := t.session.Token()
+	resp, err := t.wrapped.RoundTrip(withToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	// the session expired, sign in again and retry the request once
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	if err := t.session.Refresh(token); err != nil {
+		return nil, err
+	}
+
+	retry := withToken(req, t.session.Token())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return t.wrapped.RoundTrip(retry)
+}
+
+func withToken(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return r
 }
 
-func HttpClientWithToken(token string) *http.Client {
+func HttpClientWithSession(session *Session) *http.Client {
 	return &http.Client{
 		Transport: &authedTransport{
-			token:   token,
-			wrapped: http.DefaultTransport,
+			session: session,
+			wrapped: transport,
 		},
 	}
 }
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuthedTransportRefreshesExpiredToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	signIns := 0
+	session, err := NewSession(server.URL, "3.19", func(baseURL, apiVersion string) (string, string, error) {
+		signIns++
+		return fmt.Sprintf("token-%d", signIns), "site-id", nil
+	})
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+
+	resp, err := HttpClientWithSession(session).Post(server.URL, "application/json", strings.NewReader(`{"query":"{}"}`))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK || string(body) != `{"query":"{}"}` {
+		t.Errorf("Post() = %d %s, want the request to be replayed after signing in again", resp.StatusCode, body)
+	}
+	if signIns != 2 {
+		t.Errorf("signed in %d times, want 2", signIns)
+	}
+}
@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConnectedAppJWT(t *testing.T) {
+	app := &ConnectedApp{ClientId: "client", SecretId: "secret-id", SecretValue: "secret-value", Username: "admin@example.com"}
+	now := time.Unix(1700000000, 0)
+
+	token, err := app.JWT(now)
+	if err != nil {
+		t.Fatalf("JWT() error = %v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT() = %s, want three parts", token)
+	}
+
+	mac := hmac.New(sha256.New, []byte("secret-value"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if want := base64.RawURLEncoding.EncodeToString(mac.Sum(nil)); parts[2] != want {
+		t.Errorf("JWT() signature = %s, want %s", parts[2], want)
+	}
+
+	var header jwtHeader
+	var claims jwtClaims
+	for i, v := range []interface{}{&header, &claims} {
+		decoded, err := base64.RawURLEncoding.DecodeString(parts[i])
+		if err != nil {
+			t.Fatalf("failed to decode part %d: %v", i, err)
+		}
+		if err := json.Unmarshal(decoded, v); err != nil {
+			t.Fatalf("failed to unmarshal part %d: %v", i, err)
+		}
+	}
+
+	if want := (jwtHeader{Alg: "HS256", Typ: "JWT", Kid: "secret-id", Iss: "client"}); header != want {
+		t.Errorf("JWT() header = %+v, want %+v", header, want)
+	}
+	if claims.Sub != "admin@example.com" || claims.Aud != "tableau" || claims.Exp != now.Add(5*time.Minute).Unix() || claims.Jti == "" {
+		t.Errorf("JWT() claims = %+v", claims)
+	}
+	if !reflect.DeepEqual(claims.Scp, DefaultConnectedAppScopes) {
+		t.Errorf("JWT() scopes = %v, want %v", claims.Scp, DefaultConnectedAppScopes)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 type LoginResponse struct {
@@ -28,31 +29,51 @@ type LoginResponseUser struct {
 	ID string `xml:"id,attr"`
 }
 
-func LoginUserPassword(baseURL, apiVersion, site, username, password string) (token, siteId string, err error) {
+type LoginRequest struct {
+	XMLName     xml.Name                `xml:"tsRequest"`
+	Credentials LoginRequestCredentials `xml:"credentials"`
+}
 
-	var payload = []byte(fmt.Sprintf(`
-<tsRequest>
-  <credentials name="%s" password="%s" >
-    <site contentUrl="%s" />
-  </credentials>
-</tsRequest>
-`, username, password, site))
+type LoginRequestCredentials struct {
+	Name                      string           `xml:"name,attr,omitempty"`
+	Password                  string           `xml:"password,attr,omitempty"`
+	PersonalAccessTokenName   string           `xml:"personalAccessTokenName,attr,omitempty"`
+	PersonalAccessTokenSecret string           `xml:"personalAccessTokenSecret,attr,omitempty"`
+	JWT                       string           `xml:"jwt,attr,omitempty"`
+	Site                      LoginRequestSite `xml:"site"`
+}
 
-	return login(baseURL, apiVersion, payload)
+type LoginRequestSite struct {
+	ContentUrl string `xml:"contentUrl,attr"`
+}
+
+func LoginUserPassword(baseURL, apiVersion, site, username, password string) (token, siteId string, err error) {
+	return login(baseURL, apiVersion, LoginRequestCredentials{
+		Name:     username,
+		Password: password,
+		Site:     LoginRequestSite{ContentUrl: site},
+	})
 }
 
 func LoginPersonalAccessToken(baseURL, apiVersion, site, tokenName, tokenValue string) (token, siteId string, err error) {
+	return login(baseURL, apiVersion, LoginRequestCredentials{
+		PersonalAccessTokenName:   tokenName,
+		PersonalAccessTokenSecret: tokenValue,
+		Site:                      LoginRequestSite{ContentUrl: site},
+	})
+}
 
-	var payload = []byte(fmt.Sprintf(`
-<tsRequest>
-  <credentials personalAccessTokenName="%s"
-    personalAccessTokenSecret="%s" >
-	  <site contentUrl="%s" />
-  </credentials>
-</tsRequest>
-`, tokenName, tokenValue, site))
+func LoginConnectedApp(baseURL, apiVersion, site string, app *ConnectedApp) (token, siteId string, err error) {
 
-	return login(baseURL, apiVersion, payload)
+	jwt, err := app.JWT(time.Now())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create JWT: %w", err)
+	}
+
+	return login(baseURL, apiVersion, LoginRequestCredentials{
+		JWT:  jwt,
+		Site: LoginRequestSite{ContentUrl: site},
+	})
 }
 
 type ServerInfoResponse struct {
@@ -65,8 +86,8 @@ type ServerInfoResponse struct {
 	} `json:"serverInfo"`
 }
 
-func GetVersion(connectionUri string) (string, error) {
-	client := &http.Client{}
+func GetVersion(connectionUri string, progress io.Writer) (string, error) {
+	client := &http.Client{Transport: transport}
 	versionReq, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/2.4/serverInfo", connectionUri), nil)
 	if err != nil {
 		return "", err
@@ -87,20 +108,25 @@ func GetVersion(connectionUri string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	fmt.Printf("Tableau server version: %s\n", serverInfoResponse.ServerInfo.ProductVersion.Value)
-	fmt.Printf("Tableau API version: %s\n", serverInfoResponse.ServerInfo.RestApiVersion)
+	fmt.Fprintf(progress, "Tableau server version: %s\n", serverInfoResponse.ServerInfo.ProductVersion.Value)
+	fmt.Fprintf(progress, "Tableau API version: %s\n", serverInfoResponse.ServerInfo.RestApiVersion)
 
 	return serverInfoResponse.ServerInfo.RestApiVersion, nil
 }
 
-func login(baseURL, apiVersion string, payload []byte) (token, siteId string, err error) {
+func login(baseURL, apiVersion string, credentials LoginRequestCredentials) (token, siteId string, err error) {
+	payload, err := xml.Marshal(LoginRequest{Credentials: credentials})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create login request: %w", err)
+	}
+
 	loginURL := fmt.Sprintf("%s/api/%s/auth/signin", baseURL, apiVersion)
 	req, err := http.NewRequest(http.MethodPost, loginURL, bytes.NewBuffer(payload))
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create login request: %w", err)
 	}
 
-	client := &http.Client{}
+	client := &http.Client{Transport: transport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to send login request: %w", err)
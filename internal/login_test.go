@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLoginUserPasswordEscapesCredentials(t *testing.T) {
+	var request LoginRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.19/auth/signin" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := xml.Unmarshal(body, &request); err != nil {
+			t.Errorf("malformed signin request %s: %v", body, err)
+		}
+		w.Write([]byte(`<tsResponse><credentials token="token"><site id="site-id"/><user id="user-id"/></credentials></tsResponse>`))
+	}))
+	defer server.Close()
+
+	token, siteId, err := LoginUserPassword(server.URL, "3.19", "synqtest", "admin", `p"a&s<s'`)
+	if err != nil {
+		t.Fatalf("LoginUserPassword() error = %v", err)
+	}
+	if token != "token" || siteId != "site-id" {
+		t.Errorf("LoginUserPassword() = %s, %s, want token, site-id", token, siteId)
+	}
+
+	want := LoginRequestCredentials{Name: "admin", Password: `p"a&s<s'`, Site: LoginRequestSite{ContentUrl: "synqtest"}}
+	if request.Credentials != want {
+		t.Errorf("signin credentials = %+v, want %+v", request.Credentials, want)
+	}
+}
@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Verbose enables progress output of pagination on stderr.
+var Verbose bool
+
+// growAfter is the number of successful pages after which a shrunk page size is doubled again.
+const growAfter = 3
+
+// PageInfo is implemented by the pageInfo of every genqlient connection response.
+type PageInfo interface {
+	GetHasNextPage() bool
+	GetEndCursor() string
+}
+
+// FetchPage requests a single page of a connection starting after the given cursor, nil for the first page.
+type FetchPage[T any] func(ctx context.Context, first int, after *string) ([]T, PageInfo, error)
+
+// Paginate follows `endCursor` of a connection until `hasNextPage` is false and returns all nodes.
+// Pages rejected by the Metadata API for exceeding its node limit or timing out are retried with half
+// the page size, which grows back towards perPage once pages succeed again.
+func Paginate[T any](ctx context.Context, perPage int, fetch FetchPage[T]) ([]T, error) {
+	return paginate(ctx, perPage, nil, true, fetch, nil)
+}
+
+// paginate starts after the given cursor and calls onPage, if set, with every page it fetched. The
+// nodes are only collected and returned with keep.
+func paginate[T any](ctx context.Context, perPage int, after *string, keep bool, fetch FetchPage[T], onPage func(page []T, pageInfo PageInfo) error) ([]T, error) {
+	var nodes []T
+	if keep {
+		nodes = make([]T, 0)
+	}
+	first, succeeded := perPage, 0
+	for {
+		page, pageInfo, err := fetch(ctx, first, after)
+		if err != nil {
+			if first > 1 && IsQueryLimitError(err) {
+				first, succeeded = first/2, 0
+				verbosef("Metadata API rejected the page (%v), retrying with page size %d\n", err, first)
+				continue
+			}
+			return nil, err
+		}
+		if keep {
+			nodes = append(nodes, page...)
+		}
+		endCursor := pageInfo.GetEndCursor()
+		if pageInfo.GetHasNextPage() && (endCursor == "" || (after != nil && *after == endCursor)) {
+			return nil, errors.New("connection reported a next page without advancing the cursor")
+		}
+		if onPage != nil {
+			if err := onPage(page, pageInfo); err != nil {
+				return nil, err
+			}
+		}
+		if !pageInfo.GetHasNextPage() {
+			return nodes, nil
+		}
+		after = &endCursor
+
+		if succeeded++; first < perPage && succeeded >= growAfter {
+			first, succeeded = first*2, 0
+			if first > perPage {
+				first = perPage
+			}
+			verbosef("Increasing page size to %d\n", first)
+		}
+	}
+}
+
+// IsQueryLimitError reports whether the Metadata API refused a query for exceeding its node limit or
+// timing out, both of which can be avoided by asking for fewer nodes at once. Only GraphQL errors are
+// considered, failures of the transport like a gateway or network timeout are not caused by the query.
+func IsQueryLimitError(err error) bool {
+	var list gqlerror.List
+	if errors.As(err, &list) {
+		for _, e := range list {
+			if isQueryLimitGraphQLError(e) {
+				return true
+			}
+		}
+		return false
+	}
+	var e *gqlerror.Error
+	return errors.As(err, &e) && isQueryLimitGraphQLError(e)
+}
+
+func isQueryLimitGraphQLError(e *gqlerror.Error) bool {
+	if code, ok := e.Extensions["code"].(string); ok && isQueryLimitMessage(code) {
+		return true
+	}
+	return isQueryLimitMessage(e.Message)
+}
+
+func isQueryLimitMessage(message string) bool {
+	message = strings.ToLower(message)
+	for _, s := range []string{"node limit", "node_limit", "timed out", "timeout"} {
+		if strings.Contains(message, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func verbosef(format string, args ...interface{}) {
+	if Verbose {
+		fmt.Fprintf(os.Stderr, format, args...)
+	}
+}
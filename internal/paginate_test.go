@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type testPageInfo struct {
+	hasNextPage bool
+	endCursor   string
+}
+
+func (p *testPageInfo) GetHasNextPage() bool { return p.hasNextPage }
+func (p *testPageInfo) GetEndCursor() string { return p.endCursor }
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	var cursors []string
+
+	got, err := Paginate(context.Background(), 2, func(ctx context.Context, first int, after *string) ([]int, PageInfo, error) {
+		offset := 0
+		if after != nil {
+			cursors = append(cursors, *after)
+			fmt.Sscanf(*after, "c%d", &offset)
+		}
+		end := offset + first
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[offset:end], &testPageInfo{hasNextPage: end < len(items), endCursor: fmt.Sprintf("c%d", end)}, nil
+	})
+	if err != nil {
+		t.Fatalf("Paginate() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, items) {
+		t.Errorf("Paginate() = %v, want %v", got, items)
+	}
+	if want := []string{"c2", "c4"}; !reflect.DeepEqual(cursors, want) {
+		t.Errorf("Paginate() cursors = %v, want %v", cursors, want)
+	}
+}
+
+func TestPaginateStuckCursor(t *testing.T) {
+	_, err := Paginate(context.Background(), 2, func(ctx context.Context, first int, after *string) ([]int, PageInfo, error) {
+		return []int{1}, &testPageInfo{hasNextPage: true, endCursor: "same"}, nil
+	})
+	if err == nil {
+		t.Errorf("Paginate() expected error for a cursor that does not advance")
+	}
+}
+
+func TestPaginateShrinksPageSize(t *testing.T) {
+	var sizes []int
+	served := 0
+	got, err := Paginate(context.Background(), 8, func(ctx context.Context, first int, after *string) ([]int, PageInfo, error) {
+		sizes = append(sizes, first)
+		if first > 2 && served == 0 {
+			return nil, nil, gqlerror.List{{Message: "Showing partial results. The request exceeded the 20000 node limit."}}
+		}
+		size := first
+		if served+size > 20 {
+			size = 20 - served
+		}
+		page := make([]int, size)
+		served += size
+		return page, &testPageInfo{hasNextPage: served < 20, endCursor: fmt.Sprintf("c%d", served)}, nil
+	})
+	if err != nil {
+		t.Fatalf("Paginate() error = %v", err)
+	}
+	if len(got) != 20 {
+		t.Errorf("Paginate() returned %d nodes, want 20", len(got))
+	}
+	if want := []int{8, 4, 2, 2, 2, 4, 4, 4, 8}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("Paginate() page sizes = %v, want %v", sizes, want)
+	}
+}
+
+func TestIsQueryLimitError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{gqlerror.List{{Message: "The request exceeded the 20000 node limit"}}, true},
+		{gqlerror.List{{Message: "Something", Extensions: map[string]interface{}{"code": "TIMEOUT"}}}, true},
+		{gqlerror.List{{Message: "Field 'foo' doesn't exist"}}, false},
+		{fmt.Errorf("failed: %w", &gqlerror.Error{Message: "Query timed out"}), true},
+		// transport failures are retried as they are, not with smaller pages
+		{fmt.Errorf("returned error 504 Gateway Timeout: "), false},
+		{fmt.Errorf("Post: %w", context.DeadlineExceeded), false},
+		{fmt.Errorf("dial tcp: i/o timeout"), false},
+		{fmt.Errorf("connection refused"), false},
+	}
+	for _, tt := range tests {
+		if got := IsQueryLimitError(tt.err); got != tt.want {
+			t.Errorf("IsQueryLimitError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type RetryOptions struct {
+	// MaxRetries is how many times a failed request is repeated before giving up.
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the exponential backoff between retries.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Timeout limits every single attempt, zero means no limit.
+	Timeout time.Duration
+	// RequestsPerSecond limits the rate of requests sent to Tableau, zero means no limit.
+	RequestsPerSecond float64
+}
+
+var DefaultRetryOptions = RetryOptions{
+	MaxRetries:        5,
+	MinBackoff:        time.Second,
+	MaxBackoff:        30 * time.Second,
+	Timeout:           2 * time.Minute,
+	RequestsPerSecond: 10,
+}
+
+// transport is used by every request sent to Tableau, login and GraphQL alike.
+var transport http.RoundTripper = NewRetryTransport(http.DefaultTransport, DefaultRetryOptions)
+
+// ConfigureRetries replaces the retry options of all requests sent to Tableau.
+func ConfigureRetries(opts RetryOptions) {
+	transport = NewRetryTransport(http.DefaultTransport, opts)
+}
+
+type retryTransport struct {
+	opts    RetryOptions
+	limiter *rateLimiter
+	wrapped http.RoundTripper
+}
+
+func NewRetryTransport(wrapped http.RoundTripper, opts RetryOptions) http.RoundTripper {
+	return &retryTransport{opts: opts, limiter: newRateLimiter(opts.RequestsPerSecond), wrapped: wrapped}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(req)
+
+		// requests with a body which cannot be replayed are sent only once
+		if attempt >= t.opts.MaxRetries || req.Context().Err() != nil || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+		if err == nil && !retryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait, reason := t.backoff(attempt), fmt.Sprint(err)
+		if err == nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				wait = t.clamp(req.Context(), retryAfter)
+			}
+			reason = resp.Status
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		verbosef("Retrying %s in %s after %s\n", req.URL.Path, wait.Round(time.Millisecond), reason)
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.opts.Timeout)
+	}
+	r := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		r.Body = body
+	}
+
+	resp, err := t.wrapped.RoundTrip(r)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the timeout has to outlive RoundTrip until the body has been read
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff is exponential in the attempt with jitter between half and the full delay.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	wait := t.opts.MinBackoff << attempt
+	if wait > t.opts.MaxBackoff || wait <= 0 {
+		wait = t.opts.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// clamp bounds the delay a server asked for by MaxBackoff and by the deadline of ctx, which a longer
+// wait would miss anyway.
+func (t *retryTransport) clamp(ctx context.Context, wait time.Duration) time.Duration {
+	if t.opts.MaxBackoff > 0 && wait > t.opts.MaxBackoff {
+		wait = t.opts.MaxBackoff
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); wait > remaining {
+			wait = remaining
+		}
+	}
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter reads the Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// rateLimiter spaces requests evenly so that at most perSecond of them start every second.
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newRateLimiter(perSecond float64) *rateLimiter {
+	if perSecond <= 0 {
+		return &rateLimiter{}
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
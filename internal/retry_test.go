@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		want     int
+		attempts int
+	}{
+		{name: "success", statuses: []int{200}, want: 200, attempts: 1},
+		{name: "rate limited", statuses: []int{429, 503, 200}, want: 200, attempts: 3},
+		{name: "gives up", statuses: []int{503, 503, 503, 503}, want: 503, attempts: 3},
+		{name: "client error", statuses: []int{400, 200}, want: 400, attempts: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if body, _ := io.ReadAll(r.Body); string(body) != "payload" {
+					t.Errorf("attempt %d sent body %q", attempts, body)
+				}
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.statuses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, RetryOptions{MaxRetries: 2, MinBackoff: time.Hour, MaxBackoff: time.Hour, Timeout: time.Second})}
+			resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.want || attempts != tt.attempts {
+				t.Errorf("Post() = %d after %d attempts, want %d after %d", resp.StatusCode, attempts, tt.want, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransportClampsRetryAfter(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, RetryOptions{MaxRetries: 1, MaxBackoff: 10 * time.Millisecond})}
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || attempts != 2 || time.Since(start) > time.Second {
+		t.Errorf("Get() = %d after %d attempts in %s, want 200 after 2 within MaxBackoff", resp.StatusCode, attempts, time.Since(start))
+	}
+
+	clamp := (&retryTransport{opts: RetryOptions{MaxBackoff: time.Hour}}).clamp
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if wait := clamp(ctx, 2*time.Hour); wait <= 0 || wait > time.Minute {
+		t.Errorf("clamp() = %s, want at most the remaining minute", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait, ok := parseRetryAfter("7"); !ok || wait != 7*time.Second {
+		t.Errorf("parseRetryAfter(7) = %v, %v", wait, ok)
+	}
+	if wait, ok := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)); !ok || wait <= 0 || wait > time.Minute {
+		t.Errorf("parseRetryAfter(date) = %v, %v", wait, ok)
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Errorf("parseRetryAfter(soon) should not parse")
+	}
+}
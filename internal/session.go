@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// SignIn authenticates against the REST API and returns the session token and site ID.
+type SignIn func(baseURL, apiVersion string) (token, siteId string, err error)
+
+// Session is a signed in REST API session, it has to be closed with SignOut once the run is over.
+type Session struct {
+	baseURL    string
+	apiVersion string
+	signIn     SignIn
+
+	mu     sync.RWMutex
+	token  string
+	siteId string
+}
+
+func NewSession(baseURL, apiVersion string, signIn SignIn) (*Session, error) {
+	s := &Session{baseURL: baseURL, apiVersion: apiVersion, signIn: signIn}
+	token, siteId, err := signIn(baseURL, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	s.token, s.siteId = token, siteId
+	return s, nil
+}
+
+func (s *Session) Token() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.token
+}
+
+func (s *Session) SiteId() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.siteId
+}
+
+// Refresh signs in again unless the stale token has already been replaced by a concurrent refresh.
+func (s *Session) Refresh(stale string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != stale {
+		return nil
+	}
+
+	token, siteId, err := s.signIn(s.baseURL, s.apiVersion)
+	if err != nil {
+		return fmt.Errorf("failed to sign in again: %w", err)
+	}
+	s.token, s.siteId = token, siteId
+	return nil
+}
+
+// SignOut invalidates the token on the server, calling it more than once is a no-op.
+func (s *Session) SignOut() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == "" {
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/%s/auth/signout", s.baseURL, s.apiVersion), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create sign out request: %w", err)
+	}
+	req.Header.Set("X-Tableau-Auth", s.token)
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send sign out request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to sign out - server responded with status code: %d - %s", resp.StatusCode, string(body))
+	}
+
+	s.token = ""
+	return nil
+}
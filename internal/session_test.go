@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSessionSignOut(t *testing.T) {
+	signOuts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.19/auth/signout" || r.Header.Get("X-Tableau-Auth") != "token" {
+			t.Errorf("unexpected request %s with token %q", r.URL.Path, r.Header.Get("X-Tableau-Auth"))
+		}
+		signOuts++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	session, err := NewSession(server.URL, "3.19", func(baseURL, apiVersion string) (string, string, error) {
+		return "token", "site-id", nil
+	})
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+	if session.Token() != "token" || session.SiteId() != "site-id" {
+		t.Errorf("NewSession() = %s, %s, want token, site-id", session.Token(), session.SiteId())
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := session.SignOut(); err != nil {
+			t.Fatalf("SignOut() error = %v", err)
+		}
+	}
+	if signOuts != 1 {
+		t.Errorf("SignOut() signed out %d times, want 1", signOuts)
+	}
+	if session.Token() != "" {
+		t.Errorf("Token() = %s after sign out, want empty", session.Token())
+	}
+}
@@ -0,0 +1,126 @@
+package lineage
+
+import "github.com/getsynq/connections-tableau/metadata"
+
+type Node struct {
+	Id         string `json:"id"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	ParentId   string `json:"parentId,omitempty"`
+	ParentType string `json:"parentType,omitempty"`
+	ParentName string `json:"parentName,omitempty"`
+}
+
+// Edge is a step of column level lineage, e.g. column → datasource field → calculated field → sheet.
+// Calculated fields and sheets are linked to the fields they use directly, columns to every field
+// downstream of them, so a column is also linked to the calculated fields built on its datasource field.
+type Edge struct {
+	Source Node `json:"source"`
+	Target Node `json:"target"`
+}
+
+type named interface {
+	GetTypename() string
+	GetId() string
+	GetName() string
+}
+
+func node(typename, id, name string, parent named) Node {
+	n := Node{Id: id, Type: typename, Name: name}
+	if parent != nil {
+		n.ParentId = parent.GetId()
+		n.ParentType = parent.GetTypename()
+		n.ParentName = parent.GetName()
+	}
+	return n
+}
+
+// Builder assembles the lineage edge list page by page, without duplicates.
+type Builder struct {
+	edges []Edge
+	seen  map[[2]string]bool
+}
+
+func NewBuilder() *Builder {
+	return &Builder{edges: make([]Edge, 0), seen: map[[2]string]bool{}}
+}
+
+// Edges returns the edges added so far.
+func (b *Builder) Edges() []Edge {
+	return b.edges
+}
+
+func (b *Builder) add(source, target Node) {
+	if source.Id == "" || target.Id == "" {
+		return
+	}
+	key := [2]string{source.Id, target.Id}
+	if b.seen[key] {
+		return
+	}
+	b.seen[key] = true
+	b.edges = append(b.edges, Edge{Source: source, Target: target})
+}
+
+// Columns adds the edges from warehouse columns to the fields referencing them or downstream of them.
+func (b *Builder) Columns(columns []metadata.GetColumnLineageColumnsConnectionNodesColumn) {
+	for _, column := range columns {
+		source := node("Column", column.Id, column.Name, column.Table)
+		for _, field := range column.ReferencedByFields {
+			b.add(source, node(field.Typename, field.Id, field.Name, field.Datasource))
+		}
+		// referencedByFields only lists column fields, fields further downstream are listed separately
+		for _, field := range column.DownstreamFields {
+			if field == nil {
+				continue
+			}
+			b.add(source, node(field.GetTypename(), field.GetId(), field.GetName(), field.GetDatasource()))
+		}
+	}
+}
+
+// CalculatedFields adds the edges from the fields used in calculated fields to them.
+func (b *Builder) CalculatedFields(calculatedFields []metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) {
+	for _, calculatedField := range calculatedFields {
+		target := node(calculatedField.Typename, calculatedField.Id, calculatedField.Name, calculatedField.Datasource)
+		for _, field := range calculatedField.UpstreamFields {
+			if field == nil {
+				continue
+			}
+			b.add(node(field.GetTypename(), field.GetId(), field.GetName(), field.GetDatasource()), target)
+		}
+	}
+}
+
+// Sheets adds the edges from the fields used in sheets to them.
+func (b *Builder) Sheets(sheets []metadata.GetSheetFieldLineageSheetsConnectionNodesSheet) {
+	for _, sheet := range sheets {
+		target := Node{Id: sheet.Id, Type: sheet.Typename, Name: sheet.Name, ParentId: sheet.Workbook.Id, ParentType: "Workbook", ParentName: sheet.Workbook.Name}
+		for _, field := range sheet.SheetFieldInstances {
+			if field == nil {
+				continue
+			}
+			instance := node(field.GetTypename(), field.GetId(), field.GetName(), field.GetDatasource())
+			b.add(instance, target)
+			// fields of embedded datasources point at the field of the published datasource they were taken from
+			if datasourceField, ok := field.(*metadata.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField); ok && datasourceField.RemoteField != nil {
+				remoteField := datasourceField.RemoteField
+				b.add(node(remoteField.GetTypename(), remoteField.GetId(), remoteField.GetName(), remoteField.GetDatasource()), instance)
+			}
+		}
+	}
+}
+
+// Merge keeps the edges of previous which are not stale and adds the edges of refetched, without duplicates.
+func Merge(previous []Edge, stale func(edge Edge) bool, refetched []Edge) []Edge {
+	b := &Builder{edges: make([]Edge, 0, len(previous)+len(refetched)), seen: map[[2]string]bool{}}
+	for _, edge := range previous {
+		if !stale(edge) {
+			b.add(edge.Source, edge.Target)
+		}
+	}
+	for _, edge := range refetched {
+		b.add(edge.Source, edge.Target)
+	}
+	return b.edges
+}
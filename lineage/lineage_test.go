@@ -0,0 +1,118 @@
+package lineage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/metadata"
+)
+
+func TestBuilderColumns(t *testing.T) {
+	published := &metadata.GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource{Typename: "PublishedDatasource", Id: "p1", Name: "Orders"}
+	columns := []metadata.GetColumnLineageColumnsConnectionNodesColumn{{
+		Id: "c1", Name: "amount",
+		Table: &metadata.GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable{Typename: "DatabaseTable", Id: "t1", Name: "orders"},
+		ReferencedByFields: []metadata.GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField{
+			{Typename: "ColumnField", Id: "f1", Name: "Amount", Datasource: published},
+		},
+		DownstreamFields: []metadata.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField{
+			// also referenced directly, it is not added twice
+			&metadata.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField{
+				Typename: "ColumnField", Id: "f1", Name: "Amount",
+				Datasource: &metadata.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource{Typename: "PublishedDatasource", Id: "p1", Name: "Orders"},
+			},
+			&metadata.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField{
+				Typename: "CalculatedField", Id: "f2", Name: "Revenue",
+				Datasource: &metadata.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource{Typename: "EmbeddedDatasource", Id: "e1", Name: "Sales"},
+			},
+			nil,
+		},
+	}}
+
+	b := NewBuilder()
+	b.Columns(columns)
+	got := b.Edges()
+
+	source := Node{Id: "c1", Type: "Column", Name: "amount", ParentId: "t1", ParentType: "DatabaseTable", ParentName: "orders"}
+	want := []Edge{
+		{Source: source, Target: Node{Id: "f1", Type: "ColumnField", Name: "Amount", ParentId: "p1", ParentType: "PublishedDatasource", ParentName: "Orders"}},
+		{Source: source, Target: Node{Id: "f2", Type: "CalculatedField", Name: "Revenue", ParentId: "e1", ParentType: "EmbeddedDatasource", ParentName: "Sales"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Columns() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuilderCalculatedFields(t *testing.T) {
+	calculatedFields := []metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField{{
+		Typename: "CalculatedField", Id: "f2", Name: "Revenue",
+		Datasource: &metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource{Typename: "EmbeddedDatasource", Id: "e1", Name: "Sales"},
+		UpstreamFields: []metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField{
+			&metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField{
+				Typename: "ColumnField", Id: "f1", Name: "Amount",
+				Datasource: &metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource{Typename: "EmbeddedDatasource", Id: "e1", Name: "Sales"},
+			},
+			// calculated fields built on other calculated fields point at them, not at their fields
+			&metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField{
+				Typename: "CalculatedField", Id: "f3", Name: "Discount",
+				Datasource: &metadata.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource{Typename: "EmbeddedDatasource", Id: "e1", Name: "Sales"},
+			},
+			nil,
+		},
+	}}
+
+	b := NewBuilder()
+	b.CalculatedFields(calculatedFields)
+	got := b.Edges()
+
+	target := Node{Id: "f2", Type: "CalculatedField", Name: "Revenue", ParentId: "e1", ParentType: "EmbeddedDatasource", ParentName: "Sales"}
+	want := []Edge{
+		{Source: Node{Id: "f1", Type: "ColumnField", Name: "Amount", ParentId: "e1", ParentType: "EmbeddedDatasource", ParentName: "Sales"}, Target: target},
+		{Source: Node{Id: "f3", Type: "CalculatedField", Name: "Discount", ParentId: "e1", ParentType: "EmbeddedDatasource", ParentName: "Sales"}, Target: target},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CalculatedFields() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuilderSheets(t *testing.T) {
+	embedded := &metadata.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource{Typename: "EmbeddedDatasource", Id: "e1", Name: "Sales"}
+	sheets := []metadata.GetSheetFieldLineageSheetsConnectionNodesSheet{{
+		Typename: "Sheet", Id: "s1", Name: "Overview",
+		Workbook: metadata.GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook{Id: "w1", Name: "Sales"},
+		SheetFieldInstances: []metadata.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField{
+			&metadata.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField{
+				Typename: "CalculatedField", Id: "f2", Name: "Revenue", Datasource: embedded,
+			},
+			// the field of the embedded datasource was taken from a published datasource
+			&metadata.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField{
+				Typename: "DatasourceField", Id: "f4", Name: "Region", Datasource: embedded,
+				RemoteField: &metadata.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField{
+					Typename: "ColumnField", Id: "f5", Name: "Region",
+					Datasource: &metadata.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource{Typename: "PublishedDatasource", Id: "p1", Name: "Orders"},
+				},
+			},
+			// without a remote field there is no hop to a published datasource
+			&metadata.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField{
+				Typename: "DatasourceField", Id: "f6", Name: "Country", Datasource: embedded,
+			},
+			nil,
+		},
+	}}
+
+	b := NewBuilder()
+	b.Sheets(sheets)
+	got := b.Edges()
+
+	sheet := Node{Id: "s1", Type: "Sheet", Name: "Overview", ParentId: "w1", ParentType: "Workbook", ParentName: "Sales"}
+	region := Node{Id: "f4", Type: "DatasourceField", Name: "Region", ParentId: "e1", ParentType: "EmbeddedDatasource", ParentName: "Sales"}
+	want := []Edge{
+		{Source: Node{Id: "f2", Type: "CalculatedField", Name: "Revenue", ParentId: "e1", ParentType: "EmbeddedDatasource", ParentName: "Sales"}, Target: sheet},
+		{Source: region, Target: sheet},
+		{Source: Node{Id: "f5", Type: "ColumnField", Name: "Region", ParentId: "p1", ParentType: "PublishedDatasource", ParentName: "Orders"}, Target: region},
+		{Source: Node{Id: "f6", Type: "DatasourceField", Name: "Country", ParentId: "e1", ParentType: "EmbeddedDatasource", ParentName: "Sales"}, Target: sheet},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sheets() = %+v, want %+v", got, want)
+	}
+}
@@ -2,38 +2,113 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/Khan/genqlient/graphql"
+	"github.com/getsynq/connections-tableau/catalog"
+	"github.com/getsynq/connections-tableau/filter"
 	"github.com/getsynq/connections-tableau/internal"
-	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/getsynq/connections-tableau/openlineage"
+	"github.com/getsynq/connections-tableau/output"
+	"github.com/getsynq/connections-tableau/upload"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"io"
+	"net/http"
 	"net/url"
 	"os"
-	"strings"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
 	"time"
 )
 
+const (
+	authMethodPAT      = "pat"
+	authMethodPassword = "password"
+	authMethodJWT      = "jwt"
+)
+
 var TableauUrl string
 var TableauSite string
 var TableauTokenName string
 var TableauTokenValue string
 
+var TableauAuthMethod string
+var TableauUsername string
+var TableauPassword string
+var TableauConnectedApp internal.ConnectedApp
+var Filters filter.Options
+var Retries = internal.DefaultRetryOptions
+var PageSize int
+var StateDir string
+var NoCheckpoint bool
+var Resume bool
+var IncrementalFrom string
+var Format string
+var CatalogPath string
+var OpenLineage openlineage.Client
+var Output output.Destination
+var Upload upload.Client
+var UploadChunkSize int
+var KeepExport bool
+
 var rootCmd = &cobra.Command{
 	Use:   "connections-tableau",
 	Short: "Small utility to collect Tableau information which is only available with Admin permissions",
 }
 
 func init() {
-	rootCmd.PersistentFlags().StringVar(&TableauUrl, "url", "", "Full URL of Tableau (e.g. `https://prod-uk-a.online.tableau.com`)")
-	rootCmd.PersistentFlags().StringVar(&TableauSite, "site", "", "Site name (e.g. `synqtest` from https://prod-uk-a.online.tableau.com/t/synqtest/)")
-	rootCmd.PersistentFlags().StringVar(&TableauTokenName, "token_name", "", "Name of the Private Access Token (e.g. `synq`)")
-	rootCmd.PersistentFlags().StringVar(&TableauTokenValue, "token", "", "Value of Personal Access Token for Tableau with Admin permissions")
+	rootCmd.Flags().StringVar(&TableauUrl, "url", "", "Full URL of Tableau (e.g. `https://prod-uk-a.online.tableau.com`)")
+	rootCmd.Flags().StringVar(&TableauSite, "site", "", "Site name (e.g. `synqtest` from https://prod-uk-a.online.tableau.com/t/synqtest/)")
+	rootCmd.Flags().StringVar(&TableauAuthMethod, "auth-method", "", "How to sign in to Tableau: pat, password or jwt (defaults to jwt when --client-id is given, pat otherwise)")
+	rootCmd.Flags().StringVar(&TableauTokenName, "token_name", "", "Name of the Private Access Token (e.g. `synq`)")
+	rootCmd.Flags().StringVar(&TableauTokenValue, "token", "", "Value of Personal Access Token for Tableau with Admin permissions")
+	rootCmd.Flags().StringVar(&TableauPassword, "password", "", "Password of the Tableau user when signing in with --auth-method=password")
+	rootCmd.Flags().StringVar(&TableauConnectedApp.ClientId, "client-id", "", "Client ID of the Connected App when signing in with --auth-method=jwt")
+	rootCmd.Flags().StringVar(&TableauConnectedApp.SecretId, "secret-id", "", "Secret ID of the Connected App")
+	rootCmd.Flags().StringVar(&TableauConnectedApp.SecretValue, "secret-value", "", "Secret value of the Connected App")
+	rootCmd.Flags().StringVar(&TableauUsername, "username", "", "Tableau user to sign in as with a password or a Connected App")
+	rootCmd.Flags().StringSliceVar(&TableauConnectedApp.Scopes, "scope", internal.DefaultConnectedAppScopes, "Scopes requested by the Connected App JWT")
+	rootCmd.Flags().IntVar(&PageSize, "page-size", 100, "Number of nodes requested per Metadata API page, halved automatically when Tableau rejects a page for its node limit or a timeout")
+	rootCmd.Flags().StringVar(&StateDir, "state-dir", "", "Directory where the progress of the crawl is checkpointed after every page (default a directory per URL and site in connections-tableau in the user cache directory)")
+	rootCmd.Flags().BoolVar(&NoCheckpoint, "no-checkpoint", false, "Do not checkpoint the progress of the crawl, e.g. when the file system is read-only")
+	rootCmd.Flags().BoolVar(&Resume, "resume", false, "Continue the crawl from the last checkpoint in --state-dir instead of starting over")
+	rootCmd.Flags().StringVar(&IncrementalFrom, "incremental", "", "Previous export to update, only content changed since it was created and all table definitions are downloaded")
+	rootCmd.Flags().StringVar(&Format, "format", output.FormatJSON, "Format of the export: json, ndjson (streamed page by page), csv or parquet (one row per table column), openlineage (one run event per workbook and datasource), datahub (metadata change proposals) or openmetadata (entity create requests)")
+	rootCmd.Flags().StringVarP(&Output.Path, "output", "o", ".", "File or directory to write the export to, or - for stdout")
+	rootCmd.Flags().StringVar(&Output.FileName, "file-name", output.DefaultFileName, "Name of the export when --output is a directory, with placeholders {entity}, {site}, {timestamp}, {format} and {ext}")
+	rootCmd.Flags().StringVar(&Output.Compression, "compress", output.CompressionNone, "Compress the export with gzip or zstd")
+	rootCmd.Flags().StringVar(&CatalogPath, "catalog", "", "SQLite database to also write the export into as a new snapshot, created if it does not exist")
+	rootCmd.Flags().StringVar(&OpenLineage.Endpoint, "openlineage-url", "", "OpenLineage endpoint to also post the lineage of workbooks and datasources to, e.g. http://localhost:5000/api/v1/lineage")
+	rootCmd.Flags().StringVar(&OpenLineage.ApiKey, "openlineage-api-key", "", "API key sent as bearer token to --openlineage-url")
+	addUploadFlags(rootCmd)
+	rootCmd.Flags().BoolVar(&KeepExport, "keep-export", false, "Keep the export file after it was uploaded to --upload-url instead of removing it")
+	rootCmd.Flags().BoolVarP(&internal.Verbose, "verbose", "v", false, "Report retries and page size changes")
+	rootCmd.Flags().IntVar(&Retries.MaxRetries, "max-retries", Retries.MaxRetries, "How many times a request failing with a network error, 429 or 5xx is retried")
+	rootCmd.Flags().DurationVar(&Retries.Timeout, "request-timeout", Retries.Timeout, "Timeout of a single request to Tableau, 0 for none")
+	rootCmd.Flags().Float64Var(&Retries.RequestsPerSecond, "requests-per-second", Retries.RequestsPerSecond, "Maximum number of requests sent to Tableau per second, 0 for no limit")
+	rootCmd.Flags().StringSliceVar(&Filters.ConnectionTypes.Include, "connection-type", filter.DefaultConnectionTypes, "Connection types of tables to export, or all to export every connection type")
+	rootCmd.Flags().StringSliceVar(&Filters.ConnectionTypes.Exclude, "exclude-connection-type", nil, "Connection types of tables to leave out of the export")
+	rootCmd.Flags().StringSliceVar((*[]string)(&Filters.Projects), "project", nil, "Only export content of these projects")
+	rootCmd.Flags().StringSliceVar((*[]string)(&Filters.Databases), "database", nil, "Only export tables of these databases")
+	rootCmd.Flags().StringSliceVar((*[]string)(&Filters.Schemas), "schema", nil, "Only export tables of these schemas")
+	rootCmd.Flags().StringSliceVar((*[]string)(&Filters.Tables), "table", nil, "Only export tables with these names")
+	rootCmd.Flags().StringSliceVar((*[]string)(&Filters.Workbooks), "workbook", nil, "Only export these workbooks and their sheets and dashboards")
+	rootCmd.Flags().StringSliceVar((*[]string)(&Filters.Datasources), "datasource", nil, "Only export datasources with these names")
+	rootCmd.Flags().Var(optionalBool{&Filters.IsEmbedded}, "embedded", "Only export tables which are (or with =false are not) embedded in workbooks")
+	rootCmd.Flags().Var(optionalBool{&Filters.IsCertified}, "certified", "Only export tables and datasources which are (or with =false are not) certified")
+	rootCmd.Flags().Var(optionalBool{&Filters.HasActiveWarning}, "active-warning", "Only export tables and datasources which have (or with =false do not have) an active data quality warning")
+	for _, name := range []string{"embedded", "certified", "active-warning"} {
+		rootCmd.Flags().Lookup(name).NoOptDefVal = "true"
+	}
 
 	rootCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
-
 		if TableauUrl == "" {
 			err := survey.AskOne(&survey.Input{
 				Message: "Full URL of Tableau (e.g. `https://prod-uk-a.online.tableau.com`)",
@@ -52,26 +127,47 @@ func init() {
 			}
 		}
 
-		if TableauTokenName == "" {
-			err := survey.AskOne(&survey.Input{
-				Message: "Name of the Private Access Token",
-				Default: "synq",
-			}, &TableauTokenName, survey.WithValidator(survey.Required))
-			if err != nil {
-				return err
+		if TableauAuthMethod == "" {
+			TableauAuthMethod = authMethodPAT
+			if TableauConnectedApp.ClientId != "" {
+				TableauAuthMethod = authMethodJWT
 			}
 		}
 
-		if TableauTokenValue == "" {
-			err := survey.AskOne(&survey.Password{
-				Message: "Value of Personal Access Token for Tableau with Admin permissions",
-			}, &TableauTokenValue, survey.WithValidator(survey.Required))
-			if err != nil {
+		switch TableauAuthMethod {
+		case authMethodPAT:
+			if err := askRequired(&survey.Input{Message: "Name of the Private Access Token", Default: "synq"}, &TableauTokenName); err != nil {
+				return err
+			}
+			if err := askRequired(&survey.Password{Message: "Value of Personal Access Token for Tableau with Admin permissions"}, &TableauTokenValue); err != nil {
+				return err
+			}
+		case authMethodPassword:
+			if err := askRequired(&survey.Input{Message: "Username of the Tableau user with Admin permissions"}, &TableauUsername); err != nil {
+				return err
+			}
+			if err := askRequired(&survey.Password{Message: "Password of the Tableau user"}, &TableauPassword); err != nil {
+				return err
+			}
+		case authMethodJWT:
+			if err := askRequired(&survey.Input{Message: "Client ID of the Connected App"}, &TableauConnectedApp.ClientId); err != nil {
+				return err
+			}
+			if err := askRequired(&survey.Input{Message: "Secret ID of the Connected App"}, &TableauConnectedApp.SecretId); err != nil {
+				return err
+			}
+			if err := askRequired(&survey.Password{Message: "Secret value of the Connected App"}, &TableauConnectedApp.SecretValue); err != nil {
 				return err
 			}
+			if err := askRequired(&survey.Input{Message: "Tableau user the Connected App signs in as"}, &TableauUsername); err != nil {
+				return err
+			}
+		default:
+			cmd.Help()
+			return errors.Errorf("Unknown auth method %s, expected one of pat, password or jwt", TableauAuthMethod)
 		}
 
-		if TableauUrl == "" || TableauTokenName == "" || TableauTokenValue == "" {
+		if TableauUrl == "" {
 			cmd.Help()
 			return errors.New("Not all required parameters provided")
 		}
@@ -80,68 +176,258 @@ func init() {
 
 	rootCmd.RunE = func(cmd *cobra.Command, args []string) error {
 
+		if err := Filters.Compile(); err != nil {
+			return err
+		}
+		if _, err := output.New(Format, io.Discard, output.Source{}); err != nil {
+			return err
+		}
+		if err := Output.Validate(); err != nil {
+			return err
+		}
+		if Resume && NoCheckpoint {
+			return errors.New("--resume needs a checkpoint, it cannot be combined with --no-checkpoint")
+		}
+		if Upload.Endpoint != "" && Output.Path == output.Stdout {
+			return errors.New("--upload-url needs the export written to a file, not to stdout")
+		}
+
+		internal.ConfigureRetries(Retries)
+
+		// progress is printed to stdout, unless the export is written there
+		var progress io.Writer = os.Stdout
+		if Output.Path == output.Stdout {
+			progress = os.Stderr
+		}
+
 		TableauUrl = cleanupUrl(TableauUrl)
 
-		TableauApiVersion, err := internal.GetVersion(TableauUrl)
+		TableauApiVersion, err := internal.GetVersion(TableauUrl, progress)
 		if err != nil {
 			return errors.Wrap(err, "failed to obtain Tableau API version")
 		}
 
-		token, _, err := internal.LoginPersonalAccessToken(TableauUrl, TableauApiVersion, TableauSite, TableauTokenName, TableauTokenValue)
+		// an interrupt during sign in takes effect once it is done, so the session is signed out again
+		ctx := cmd.Context()
+		session, err := internal.NewSession(TableauUrl, TableauApiVersion, signIn())
+		if err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+		defer func() {
+			if err := session.SignOut(); err != nil {
+				fmt.Fprintf(os.Stderr, "failed to sign out: %v\n", err)
+			}
+		}()
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		client := graphql.NewClient(fmt.Sprintf("%s/api/metadata/graphql", TableauUrl), internal.HttpClientWithSession(session))
 
+		var checkpoint *internal.Checkpoint
+		if !NoCheckpoint {
+			stateDir := stateDir(TableauUrl, TableauSite)
+			if Resume {
+				checkpoint, err = internal.ResumeCheckpoint(stateDir, TableauUrl, TableauSite, &Filters)
+			} else {
+				checkpoint, err = internal.NewCheckpoint(stateDir, TableauUrl, TableauSite, &Filters)
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to open checkpoint")
+			}
+		}
+
+		file, err := Output.Open(Format, TableauSite, time.Now())
 		if err != nil {
-			panic(errors.Wrap(err, "failed to authenticate"))
+			return errors.Wrap(err, "failed to create export")
 		}
+		defer file.Abort()
 
-		ctx := context.Background()
+		writer, err := output.New(Format, file, output.Source{Url: TableauUrl, Site: TableauSite})
+		if err != nil {
+			return err
+		}
 
-		client := graphql.NewClient(fmt.Sprintf("%s/api/metadata/graphql", TableauUrl), internal.HttpClientWithToken(token))
+		// streaming writers get the nodes while crawling, which are then dropped, unless the catalog or
+		// OpenLineage need the complete export afterwards
+		var stream output.Writer
+		if output.Streams(Format) && CatalogPath == "" && OpenLineage.Endpoint == "" {
+			stream = writer
+		}
 
-		acceptConnectionTypes := map[string]bool{"bigquery": true, "snowflake": true, "redshift": true, "clickhouse": true}
-		databaseTables := make([]*metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable, 0)
+		var response *model.Response
+		if IncrementalFrom != "" {
+			var previous *model.Response
+			if previous, err = readExport(IncrementalFrom); err != nil {
+				return err
+			}
+			response, err = crawlIncremental(ctx, client, PageSize, checkpoint, &Filters, previous, stream, progress)
+		} else {
+			response, err = crawl(ctx, client, PageSize, checkpoint, &Filters, stream, progress)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "crawl failed, continue it with --resume")
+		}
 
-		perPage := 100
-		page := 0
-		totalPages := 0
+		if err := writer.Write(response); err != nil {
+			return errors.Wrapf(err, "failed to write export %s", file.Name)
+		}
+		if err := file.Commit(); err != nil {
+			return errors.Wrapf(err, "failed to write export %s", file.Name)
+		}
 
-		for {
-			resp, err := metadata.GetDatabaseTablesDefinitions(ctx, client, perPage, perPage*page)
-			if err != nil {
-				panic(errors.Wrap(err, "failed to obtain metadata"))
+		if file.Name != output.Stdout {
+			fmt.Fprintf(progress, "File %s created\n", file.Name)
+		}
+
+		if CatalogPath != "" {
+			if err := writeCatalog(ctx, response, progress); err != nil {
+				return errors.Wrapf(err, "failed to write catalog %s", CatalogPath)
 			}
-			if totalPages == 0 {
-				totalPages = resp.DatabaseTablesConnection.TotalCount / perPage
+		}
+
+		if OpenLineage.Endpoint != "" {
+			if err := emitOpenLineage(ctx, response, progress); err != nil {
+				return errors.Wrapf(err, "failed to post OpenLineage events to %s", OpenLineage.Endpoint)
 			}
-			for _, databaseTable := range resp.DatabaseTablesConnection.Nodes {
-				databaseTable := databaseTable
-				if acceptConnectionTypes[databaseTable.ConnectionType] {
-					databaseTables = append(databaseTables, &databaseTable)
-				}
+		}
+
+		if Upload.Endpoint != "" {
+			key := upload.RunKey(TableauUrl, TableauSite, response.ExtractedAt)
+			if err := uploadExport(ctx, file.Name, key, progress); err != nil {
+				return errors.Wrapf(err, "failed to upload export %s, retry with the upload command and --idempotency-key %s", file.Name, key)
 			}
-			page += 1
-			if page > totalPages {
-				break
+			if !KeepExport {
+				if err := os.Remove(file.Name); err != nil {
+					return errors.Wrapf(err, "failed to remove uploaded export %s", file.Name)
+				}
 			}
 		}
 
-		fmt.Printf("Discovered %d database tables\n", len(databaseTables))
-
-		jsonBytes, err := json.MarshalIndent(databaseTables, "", "  ")
-		if err != nil {
-			panic(errors.Wrap(err, "failed to create json"))
+		if err := checkpoint.Remove(); err != nil {
+			return errors.Wrap(err, "failed to remove checkpoint")
 		}
 
-		fileName := strings.ReplaceAll(fmt.Sprintf("tables-%s.json", time.Now().UTC().Format(time.RFC3339)), ":", "_")
-		err = os.WriteFile(fileName, jsonBytes, 0644)
-		if err != nil {
-			return errors.Wrapf(err, "failed to write file %s", fileName)
+		return nil
+	}
+
+}
+
+// stateDir is --state-dir, or by default a directory in the user cache directory named after the
+// hash of the URL and site, so that crawls of different sites running at the same time do not share
+// a checkpoint.
+func stateDir(url, site string) string {
+	if StateDir != "" {
+		return StateDir
+	}
+	sum := sha256.Sum256([]byte(url + "\n" + site))
+	key := hex.EncodeToString(sum[:8])
+	if cacheDir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(cacheDir, "connections-tableau", key)
+	}
+	return filepath.Join(".connections-tableau", key)
+}
+
+// writeCatalog adds the export to the SQLite catalog as a new run.
+func writeCatalog(ctx context.Context, response *model.Response, progress io.Writer) error {
+	c, err := catalog.Open(CatalogPath)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	runId, err := c.Write(ctx, response, TableauUrl, TableauSite)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(progress, "Run %d written to catalog %s\n", runId, CatalogPath)
+	return c.Close()
+}
+
+// emitOpenLineage posts an OpenLineage event for every workbook and datasource of the export.
+func emitOpenLineage(ctx context.Context, response *model.Response, progress io.Writer) error {
+	events, err := openlineage.Events(response, openlineage.Namespace(TableauUrl, TableauSite))
+	if err != nil {
+		return err
+	}
+	OpenLineage.HttpClient = &http.Client{Transport: internal.NewRetryTransport(http.DefaultTransport, internal.RetryOptions{
+		MaxRetries: Retries.MaxRetries,
+		MinBackoff: Retries.MinBackoff,
+		MaxBackoff: Retries.MaxBackoff,
+		Timeout:    Retries.Timeout,
+	})}
+	if err := OpenLineage.Emit(ctx, events); err != nil {
+		return err
+	}
+	fmt.Fprintf(progress, "Posted %d OpenLineage events\n", len(events))
+	return nil
+}
+
+// signIn returns the sign in for the chosen auth method.
+func signIn() internal.SignIn {
+	return func(baseURL, apiVersion string) (string, string, error) {
+		switch TableauAuthMethod {
+		case authMethodPassword:
+			return internal.LoginUserPassword(baseURL, apiVersion, TableauSite, TableauUsername, TableauPassword)
+		case authMethodJWT:
+			TableauConnectedApp.Username = TableauUsername
+			return internal.LoginConnectedApp(baseURL, apiVersion, TableauSite, &TableauConnectedApp)
+		default:
+			return internal.LoginPersonalAccessToken(baseURL, apiVersion, TableauSite, TableauTokenName, TableauTokenValue)
 		}
+	}
+}
+
+func readExport(fileName string) (*model.Response, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read export %s", fileName)
+	}
+	defer file.Close()
+	r, err := output.Decompress(fileName, file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read export %s", fileName)
+	}
+	defer r.Close()
 
-		fmt.Printf("File %s created\n", fileName)
+	export, err := output.Read(fileName, r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse export %s", fileName)
+	}
+	return export, nil
+}
 
+// askRequired prompts for a value unless it was already given on the command line.
+func askRequired(prompt survey.Prompt, value *string) error {
+	if *value != "" {
 		return nil
 	}
+	return survey.AskOne(prompt, value, survey.WithValidator(survey.Required))
+}
+
+// optionalBool is a flag which is left nil unless given on the command line.
+type optionalBool struct {
+	value **bool
+}
 
+func (b optionalBool) String() string {
+	if b.value == nil || *b.value == nil {
+		return ""
+	}
+	return strconv.FormatBool(**b.value)
+}
+
+func (b optionalBool) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*b.value = &v
+	return nil
+}
+
+func (b optionalBool) Type() string {
+	return "bool"
 }
 
 func cleanupUrl(in string) string {
@@ -156,7 +442,22 @@ func cleanupUrl(in string) string {
 //go:generate go run github.com/Khan/genqlient
 func main() {
 
-	if err := rootCmd.Execute(); err != nil {
+	// cancelling the context aborts the command, after which deferred clean ups like the sign out still
+	// run. A second interrupt kills the process right away.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		if errors.Is(err, errBreakingChanges) {
+			os.Exit(1)
+		}
+		// interrupted, cobra already printed the error
+		if errors.Is(err, context.Canceled) || errors.Is(err, terminal.InterruptErr) {
+			os.Exit(130)
+		}
 		panic(err)
 	}
 
@@ -1,6 +1,17 @@
 package main
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getsynq/connections-tableau/diff"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/getsynq/connections-tableau/output"
+)
 
 func Test_cleanupUrl(t *testing.T) {
 	tests := []struct {
@@ -20,3 +31,93 @@ func Test_cleanupUrl(t *testing.T) {
 		})
 	}
 }
+
+func Test_stateDir(t *testing.T) {
+	site := stateDir("https://tableau.example.com/", "sales")
+	if site != stateDir("https://tableau.example.com/", "sales") {
+		t.Errorf("stateDir() = %s, want the same directory for the same URL and site", site)
+	}
+	for _, other := range []string{stateDir("https://tableau.example.com/", "finance"), stateDir("https://other.example.com/", "sales")} {
+		if other == site || filepath.Dir(other) != filepath.Dir(site) {
+			t.Errorf("stateDir() = %s, want a directory next to %s", other, site)
+		}
+	}
+
+	StateDir = "state"
+	defer func() { StateDir = "" }()
+	if got := stateDir("https://tableau.example.com/", "sales"); got != "state" {
+		t.Errorf("stateDir() = %s, want --state-dir", got)
+	}
+}
+
+func Test_readExportLegacy(t *testing.T) {
+	export, err := readExport("testdata/tables-2023-01-02T03_04_05Z.json")
+	if err != nil {
+		t.Fatalf("readExport() error = %v", err)
+	}
+	if len(export.DatabaseTables) != 1 {
+		t.Fatalf("readExport() = %d database tables, want 1", len(export.DatabaseTables))
+	}
+	table := export.DatabaseTables[0]
+	if table.Name != "orders" || table.FullName != "[public].[orders]" || len(table.Columns) != 1 {
+		t.Errorf("readExport() table = %+v", table)
+	}
+	if name := databaseName(table.Database); name != "analytics" {
+		t.Errorf("readExport() database = %s, want analytics", name)
+	}
+	// exports written before identifiers were added get them when read
+	if table.Identifier.Canonical != "ANALYTICS.PUBLIC.ORDERS" {
+		t.Errorf("readExport() identifier = %s, want ANALYTICS.PUBLIC.ORDERS", table.Identifier.Canonical)
+	}
+}
+
+func Test_readExportNDJSON(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, columns ...string) string {
+		table := &metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{Id: "t1", Name: "orders", FullName: "public.orders", ConnectionType: "snowflake"}
+		for _, column := range columns {
+			table.Columns = append(table.Columns, metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn{Id: column, Name: column, RemoteType: "I8"})
+		}
+		file, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+		w, err := output.New(output.FormatNDJSON, file, output.Source{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.WritePage("databaseTables", model.DatabaseTables(table)); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Write(&model.Response{ExtractedAt: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)}); err != nil {
+			t.Fatal(err)
+		}
+		return file.Name()
+	}
+
+	before, err := readExport(write("before.ndjson", "id", "amount"))
+	if err != nil {
+		t.Fatalf("readExport() error = %v", err)
+	}
+	after, err := readExport(write("after.ndjson", "id"))
+	if err != nil {
+		t.Fatalf("readExport() error = %v", err)
+	}
+	if len(before.DatabaseTables) != 1 || len(before.DatabaseTables[0].Columns) != 2 || before.ExtractedAt.IsZero() {
+		t.Fatalf("readExport() = %+v, want the table with both columns", before)
+	}
+	if report := diff.Compare(before, after); report.Breaking != 1 {
+		t.Errorf("Compare() = %d breaking changes, want the removed column", report.Breaking)
+	}
+}
+
+func Test_readExportRejectsOtherFormats(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "columns-site.csv")
+	if err := os.WriteFile(path, []byte("tableId,column\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readExport(path); err == nil || !strings.Contains(err.Error(), "only json and ndjson exports") {
+		t.Errorf("readExport() error = %v, want only json and ndjson exports to be read", err)
+	}
+}
@@ -6,105 +6,126 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/getsynq/connections-tableau/filter"
 )
 
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnection includes the requested fields of the GraphQL type CustomSQLTablesConnection.
+// Possible data types for a field.
+type FieldDataType string
+
+const (
+	FieldDataTypeBoolean  FieldDataType = "BOOLEAN"
+	FieldDataTypeDate     FieldDataType = "DATE"
+	FieldDataTypeDatetime FieldDataType = "DATETIME"
+	FieldDataTypeInteger  FieldDataType = "INTEGER"
+	FieldDataTypeReal     FieldDataType = "REAL"
+	FieldDataTypeSpatial  FieldDataType = "SPATIAL"
+	FieldDataTypeString   FieldDataType = "STRING"
+	FieldDataTypeTable    FieldDataType = "TABLE"
+	FieldDataTypeTuple    FieldDataType = "TUPLE"
+	FieldDataTypeUnknown  FieldDataType = "UNKNOWN"
+)
+
+// Possible roles of a field.
+type FieldRole string
+
+const (
+	FieldRoleDimension FieldRole = "DIMENSION"
+	FieldRoleMeasure   FieldRole = "MEASURE"
+	FieldRoleUnknown   FieldRole = "UNKNOWN"
+)
+
+// GetCalculatedFieldLineageCalculatedFieldsConnection includes the requested fields of the GraphQL type CalculatedFieldsConnection.
 // The GraphQL type's documentation follows.
 //
-// Connection Type for CustomSQLTable
-type GetCustomSQLTablesDefinitionsCustomSQLTablesConnection struct {
+// Connection Type for CalculatedField
+type GetCalculatedFieldLineageCalculatedFieldsConnection struct {
 	// List of nodes
-	Nodes []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable `json:"nodes"`
+	Nodes []GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField `json:"nodes"`
 	// Information for pagination
-	PageInfo GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo `json:"pageInfo"`
+	PageInfo GetCalculatedFieldLineageCalculatedFieldsConnectionPageInfo `json:"pageInfo"`
 	// Total number of objects in connection
 	TotalCount int `json:"totalCount"`
 }
 
-// GetNodes returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnection) GetNodes() []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable {
+// GetNodes returns GetCalculatedFieldLineageCalculatedFieldsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnection) GetNodes() []GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField {
 	return v.Nodes
 }
 
-// GetPageInfo returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnection.PageInfo, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnection) GetPageInfo() GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo {
+// GetPageInfo returns GetCalculatedFieldLineageCalculatedFieldsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnection) GetPageInfo() GetCalculatedFieldLineageCalculatedFieldsConnectionPageInfo {
 	return v.PageInfo
 }
 
-// GetTotalCount returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnection.TotalCount, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnection) GetTotalCount() int {
+// GetTotalCount returns GetCalculatedFieldLineageCalculatedFieldsConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnection) GetTotalCount() int {
 	return v.TotalCount
 }
 
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable includes the requested fields of the GraphQL type CustomSQLTable.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField includes the requested fields of the GraphQL type CalculatedField.
 // The GraphQL type's documentation follows.
 //
-// table that represents the result of evaluating a custom SQL query. These "tables" are owned by the Tableau data source (embedded or published) which contains the SQL query, so they only exist within that data source.
-type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable struct {
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField struct {
 	Typename string `json:"__typename"`
 	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
 	Id string `json:"id"`
 	// Name shown in server and desktop clients
 	Name string `json:"name"`
-	// A custom SQL tables is always embedded in Tableau content
-	IsEmbedded bool `json:"isEmbedded"`
-	// Database this query is executed on
-	Database GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase `json:"-"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
-	// User modifiable description of this table
-	Description string `json:"description"`
+	// Formula of the calculated field
+	Formula string `json:"formula"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource `json:"-"`
+	// fields that are upstream of this field
+	UpstreamFields []GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField `json:"-"`
 }
 
-// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Typename, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetTypename() string {
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) GetTypename() string {
 	return v.Typename
 }
 
-// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Id, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetId() string {
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) GetId() string {
 	return v.Id
 }
 
-// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Name, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetName() string {
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) GetName() string {
 	return v.Name
 }
 
-// GetIsEmbedded returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.IsEmbedded, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetIsEmbedded() bool {
-	return v.IsEmbedded
-}
-
-// GetDatabase returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Database, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetDatabase() GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase {
-	return v.Database
+// GetFormula returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.Formula, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) GetFormula() string {
+	return v.Formula
 }
 
-// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetConnectionType() string {
-	return v.ConnectionType
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource {
+	return v.Datasource
 }
 
-// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Description, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetDescription() string {
-	return v.Description
+// GetUpstreamFields returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.UpstreamFields, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) GetUpstreamFields() []GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField {
+	return v.UpstreamFields
 }
 
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) UnmarshalJSON(b []byte) error {
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable
-		Database json.RawMessage `json:"database"`
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField
+		Datasource     json.RawMessage   `json:"datasource"`
+		UpstreamFields []json.RawMessage `json:"upstreamFields"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable = v
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -112,37 +133,54 @@ func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTab
 	}
 
 	{
-		dst := &v.Database
-		src := firstPass.Database
+		dst := &v.Datasource
+		src := firstPass.Datasource
 		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase(
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Database: %w", err)
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.Datasource: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.UpstreamFields
+		src := firstPass.UpstreamFields
+		*dst = make(
+			[]GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.UpstreamFields: %w", err)
+				}
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable struct {
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField struct {
 	Typename string `json:"__typename"`
 
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	IsEmbedded bool `json:"isEmbedded"`
-
-	Database json.RawMessage `json:"database"`
+	Formula string `json:"formula"`
 
-	ConnectionType string `json:"connectionType"`
+	Datasource json.RawMessage `json:"datasource"`
 
-	Description string `json:"description"`
+	UpstreamFields []json.RawMessage `json:"upstreamFields"`
 }
 
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) MarshalJSON() ([]byte, error) {
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -150,76 +188,80 @@ func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTab
 	return json.Marshal(premarshaled)
 }
 
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) __premarshalJSON() (*__premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable, error) {
-	var retval __premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField
 
 	retval.Typename = v.Typename
 	retval.Id = v.Id
 	retval.Name = v.Name
-	retval.IsEmbedded = v.IsEmbedded
+	retval.Formula = v.Formula
 	{
 
-		dst := &retval.Database
-		src := v.Database
+		dst := &retval.Datasource
+		src := v.Datasource
 		var err error
-		*dst, err = __marshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase(
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"Unable to marshal GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Database: %w", err)
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.Datasource: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.UpstreamFields
+		src := v.UpstreamFields
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedField.UpstreamFields: %w", err)
+			}
 		}
 	}
-	retval.ConnectionType = v.ConnectionType
-	retval.Description = v.Description
 	return &retval, nil
 }
 
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase includes the requested fields of the GraphQL interface Database.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource includes the requested fields of the GraphQL interface Datasource.
 //
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase is implemented by the following types:
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource is implemented by the following types:
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource
 // The GraphQL type's documentation follows.
 //
-// database containing tables
-type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase interface {
-	implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase()
+// # Root GraphQL type for embedded and published data sources
+//
+// Data sources are a way to represent how Tableau Desktop and Tableau Server model and connect to data. Data sources can be published separately, as a published data source, or may be contained in a workbook as an embedded data source.
+//
+// See https://onlinehelp.tableau.com/current/server/en-us/datasource.htm
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource interface {
+	implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() string
 	// GetId returns the interface-field "id" from its implementation.
 	// The GraphQL interface field's documentation follows.
 	//
-	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
 	GetId() string
-	// GetConnectionType returns the interface-field "connectionType" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// Connection type shortname
-	GetConnectionType() string
 	// GetName returns the interface-field "name" from its implementation.
 	// The GraphQL interface field's documentation follows.
 	//
 	// Name shown in server and desktop clients
 	GetName() string
-	// GetDescription returns the interface-field "description" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// User modifiable description of this database
-	GetDescription() string
 }
 
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase() {
-}
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase() {
-}
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase() {
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource() {
 }
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase() {
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource() {
 }
 
-func __unmarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase(b []byte, v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase) error {
+func __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource(b []byte, v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -233,385 +275,361 @@ func __unmarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCusto
 	}
 
 	switch tn.TypeName {
-	case "CloudFile":
-		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile)
-		return json.Unmarshal(b, *v)
-	case "DatabaseServer":
-		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer)
-		return json.Unmarshal(b, *v)
-	case "File":
-		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile)
+	case "EmbeddedDatasource":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource)
 		return json.Unmarshal(b, *v)
-	case "WebDataConnector":
-		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector)
+	case "PublishedDatasource":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing Database.__typename")
+			"response was missing Datasource.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase: "%v"`, tn.TypeName)
+			`unexpected concrete type for GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase(v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase) ([]byte, error) {
+func __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource(v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile:
-		typename = "CloudFile"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer:
-		typename = "DatabaseServer"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile:
-		typename = "File"
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource:
+		typename = "EmbeddedDatasource"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile
+			*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource
 		}{typename, v}
 		return json.Marshal(result)
-	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector:
-		typename = "WebDataConnector"
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource:
+		typename = "PublishedDatasource"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector
+			*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase: "%T"`, v)
+			`unexpected concrete type for GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasource: "%T"`, v)
 	}
 }
 
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
 // The GraphQL type's documentation follows.
 //
-// cloud file connection
-type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile struct {
+// data source embedded in a workbook
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource struct {
 	Typename string `json:"__typename"`
-	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
 	Id string `json:"id"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
 	// Name shown in server and desktop clients
 	Name string `json:"name"`
-	// User modifiable description of this database
-	Description string `json:"description"`
 }
 
-// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetTypename() string {
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource) GetTypename() string {
 	return v.Typename
 }
 
-// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetId() string {
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource) GetId() string {
 	return v.Id
 }
 
-// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetConnectionType() string {
-	return v.ConnectionType
-}
-
-// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetName() string {
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourceEmbeddedDatasource) GetName() string {
 	return v.Name
 }
 
-// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.Description, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetDescription() string {
-	return v.Description
-}
-
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
 // The GraphQL type's documentation follows.
 //
-// database server connection
-type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer struct {
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource struct {
 	Typename string `json:"__typename"`
-	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
 	Id string `json:"id"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
 	// Name shown in server and desktop clients
 	Name string `json:"name"`
-	// User modifiable description of this database
-	Description string `json:"description"`
 }
 
-// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetTypename() string {
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource) GetTypename() string {
 	return v.Typename
 }
 
-// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetId() string {
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource) GetId() string {
 	return v.Id
 }
 
-// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetConnectionType() string {
-	return v.ConnectionType
-}
-
-// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetName() string {
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldDatasourcePublishedDatasource) GetName() string {
 	return v.Name
 }
 
-// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.Description, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetDescription() string {
-	return v.Description
-}
-
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile includes the requested fields of the GraphQL type File.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField includes the requested fields of the GraphQL type BinField.
 // The GraphQL type's documentation follows.
 //
-// file connection
-type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile struct {
+// GraphQL type for a binned continuous measure field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_bins.html
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField struct {
 	Typename string `json:"__typename"`
 	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
 	Id string `json:"id"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
-	// Name shown in server and desktop clients
+	// Name shown in server
 	Name string `json:"name"`
-	// User modifiable description of this database
-	Description string `json:"description"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
 }
 
-// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetTypename() string {
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField) GetTypename() string {
 	return v.Typename
 }
 
-// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.Id, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetId() string {
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField) GetId() string {
 	return v.Id
 }
 
-// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetConnectionType() string {
-	return v.ConnectionType
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField) GetName() string {
+	return v.Name
 }
 
-// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.Name, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetName() string {
-	return v.Name
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
 }
 
-// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.Description, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetDescription() string {
-	return v.Description
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
-// The GraphQL type's documentation follows.
-//
-// web data connector
-type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector struct {
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField struct {
 	Typename string `json:"__typename"`
-	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+
 	Id string `json:"id"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
-	// Name shown in server and desktop clients
+
 	Name string `json:"name"`
-	// User modifiable description of this database
-	Description string `json:"description"`
-}
 
-// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetTypename() string {
-	return v.Typename
+	Datasource json.RawMessage `json:"datasource"`
 }
 
-// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetId() string {
-	return v.Id
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetConnectionType() string {
-	return v.ConnectionType
-}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField
 
-// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetName() string {
-	return v.Name
-}
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
 
-// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.Description, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetDescription() string {
-	return v.Description
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField includes the requested fields of the GraphQL type CalculatedField.
 // The GraphQL type's documentation follows.
 //
-// Information about pagination in a connection
-type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo struct {
-	// Indicates if there are more objects to fetch
-	HasNextPage bool `json:"hasNextPage"`
-	// Cursor to use in subsequent query to fetch next page of objects
-	EndCursor string `json:"endCursor"`
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
 }
 
-// GetHasNextPage returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo) GetHasNextPage() bool {
-	return v.HasNextPage
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField) GetTypename() string {
+	return v.Typename
 }
 
-// GetEndCursor returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo) GetEndCursor() string {
-	return v.EndCursor
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField) GetId() string {
+	return v.Id
 }
 
-// GetCustomSQLTablesDefinitionsResponse is returned by GetCustomSQLTablesDefinitions on success.
-type GetCustomSQLTablesDefinitionsResponse struct {
-	// Fetch CustomSQLTables with support for pagination
-	CustomSQLTablesConnection GetCustomSQLTablesDefinitionsCustomSQLTablesConnection `json:"customSQLTablesConnection"`
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField) GetName() string {
+	return v.Name
 }
 
-// GetCustomSQLTablesConnection returns GetCustomSQLTablesDefinitionsResponse.CustomSQLTablesConnection, and is useful for accessing the field via an interface.
-func (v *GetCustomSQLTablesDefinitionsResponse) GetCustomSQLTablesConnection() GetCustomSQLTablesDefinitionsCustomSQLTablesConnection {
-	return v.CustomSQLTablesConnection
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
 }
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnection includes the requested fields of the GraphQL type DatabaseTablesConnection.
-// The GraphQL type's documentation follows.
-//
-// Connection Type for DatabaseTable
-type GetDatabaseTablesDefinitionsDatabaseTablesConnection struct {
-	// List of nodes
-	Nodes []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable `json:"nodes"`
-	// Information for pagination
-	PageInfo GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo `json:"pageInfo"`
-	// Total number of objects in connection
-	TotalCount int `json:"totalCount"`
-}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField) UnmarshalJSON(b []byte) error {
 
-// GetNodes returns GetDatabaseTablesDefinitionsDatabaseTablesConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnection) GetNodes() []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable {
-	return v.Nodes
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetPageInfo returns GetDatabaseTablesDefinitionsDatabaseTablesConnection.PageInfo, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnection) GetPageInfo() GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo {
-	return v.PageInfo
-}
+	var firstPass struct {
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField = v
 
-// GetTotalCount returns GetDatabaseTablesDefinitionsDatabaseTablesConnection.TotalCount, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnection) GetTotalCount() int {
-	return v.TotalCount
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
-// The GraphQL type's documentation follows.
-//
-// table that is contained in a database
-type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable struct {
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField struct {
 	Typename string `json:"__typename"`
-	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+
 	Id string `json:"id"`
-	// Name shown in server and desktop clients
+
 	Name string `json:"name"`
-	// True if this table is embedded in Tableau content
-	IsEmbedded bool `json:"isEmbedded"`
-	// The database to which this table belongs
-	Database GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase `json:"-"`
-	// Name of table schema.
-	//
-	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
-	Schema string `json:"schema"`
-	// Fully qualified table name
-	FullName string `json:"fullName"`
-	// Connection type of parent database
-	ConnectionType string `json:"connectionType"`
-	// User modifiable description of this table
-	Description string `json:"description"`
-	// Columns contained in this table
-	Columns []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn `json:"columns"`
-}
 
-// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Typename, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetTypename() string {
-	return v.Typename
+	Datasource json.RawMessage `json:"datasource"`
 }
 
-// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Id, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetId() string {
-	return v.Id
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Name, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetName() string {
-	return v.Name
-}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField
 
-// GetIsEmbedded returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.IsEmbedded, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetIsEmbedded() bool {
-	return v.IsEmbedded
-}
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
 
-// GetDatabase returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Database, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetDatabase() GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase {
-	return v.Database
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetSchema returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Schema, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetSchema() string {
-	return v.Schema
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
 }
 
-// GetFullName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.FullName, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetFullName() string {
-	return v.FullName
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField) GetTypename() string {
+	return v.Typename
 }
 
-// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetConnectionType() string {
-	return v.ConnectionType
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField) GetId() string {
+	return v.Id
 }
 
-// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Description, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetDescription() string {
-	return v.Description
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField) GetName() string {
+	return v.Name
 }
 
-// GetColumns returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Columns, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetColumns() []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn {
-	return v.Columns
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
 }
 
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) UnmarshalJSON(b []byte) error {
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable
-		Database json.RawMessage `json:"database"`
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField
+		Datasource json.RawMessage `json:"datasource"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable = v
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -619,43 +637,31 @@ func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable)
 	}
 
 	{
-		dst := &v.Database
-		src := firstPass.Database
+		dst := &v.Datasource
+		src := firstPass.Datasource
 		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase(
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Database: %w", err)
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField.Datasource: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable struct {
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField struct {
 	Typename string `json:"__typename"`
 
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	IsEmbedded bool `json:"isEmbedded"`
-
-	Database json.RawMessage `json:"database"`
-
-	Schema string `json:"schema"`
-
-	FullName string `json:"fullName"`
-
-	ConnectionType string `json:"connectionType"`
-
-	Description string `json:"description"`
-
-	Columns []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn `json:"columns"`
+	Datasource json.RawMessage `json:"datasource"`
 }
 
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) MarshalJSON() ([]byte, error) {
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -663,486 +669,16845 @@ func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable)
 	return json.Marshal(premarshaled)
 }
 
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) __premarshalJSON() (*__premarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable, error) {
-	var retval __premarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField
 
 	retval.Typename = v.Typename
 	retval.Id = v.Id
 	retval.Name = v.Name
-	retval.IsEmbedded = v.IsEmbedded
 	{
 
-		dst := &retval.Database
-		src := v.Database
+		dst := &retval.Datasource
+		src := v.Datasource
 		var err error
-		*dst, err = __marshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase(
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"Unable to marshal GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Database: %w", err)
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField.Datasource: %w", err)
 		}
 	}
-	retval.Schema = v.Schema
-	retval.FullName = v.FullName
-	retval.ConnectionType = v.ConnectionType
-	retval.Description = v.Description
-	retval.Columns = v.Columns
 	return &retval, nil
 }
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn includes the requested fields of the GraphQL type Column.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField includes the requested fields of the GraphQL type CombinedField.
 // The GraphQL type's documentation follows.
 //
-// GraphQL type for a table column
-type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn struct {
+// GraphQL type for a combined field. Combined fields concatanate fields together into one string.
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField struct {
+	Typename string `json:"__typename"`
 	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
 	Id string `json:"id"`
-	// Name of column
+	// Name shown in server
 	Name string `json:"name"`
-	// Remote type on the database. Types correspond to OLEDB types here: https://referencesource.microsoft.com/#system.data/System/Data/OleDb/OLEDB_Enum.cs,364
-	RemoteType RemoteType `json:"remoteType"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
 }
 
-// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn.Id, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn) GetId() string {
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField) GetId() string {
 	return v.Id
 }
 
-// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn.Name, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn) GetName() string {
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField) GetName() string {
 	return v.Name
 }
 
-// GetRemoteType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn.RemoteType, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn) GetRemoteType() RemoteType {
-	return v.RemoteType
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
 }
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase includes the requested fields of the GraphQL interface Database.
-//
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase is implemented by the following types:
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector
-// The GraphQL type's documentation follows.
-//
-// database containing tables
-type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase interface {
-	implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
-	// GetId returns the interface-field "id" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
-	GetId() string
-	// GetName returns the interface-field "name" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// Name shown in server and desktop clients
-	GetName() string
-	// GetConnectionType returns the interface-field "connectionType" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// Connection type shortname
-	GetConnectionType() string
-	// GetDescription returns the interface-field "description" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// User modifiable description of this database
-	GetDescription() string
-}
-
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase() {
-}
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase() {
-}
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase() {
-}
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase() {
-}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField) UnmarshalJSON(b []byte) error {
 
-func __unmarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase(b []byte, v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase) error {
 	if string(b) == "null" {
 		return nil
 	}
 
-	var tn struct {
-		TypeName string `json:"__typename"`
+	var firstPass struct {
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
 	}
-	err := json.Unmarshal(b, &tn)
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField = v
+
+	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	switch tn.TypeName {
-	case "CloudFile":
-		*v = new(GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile)
-		return json.Unmarshal(b, *v)
-	case "DatabaseServer":
-		*v = new(GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer)
-		return json.Unmarshal(b, *v)
-	case "File":
-		*v = new(GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile)
-		return json.Unmarshal(b, *v)
-	case "WebDataConnector":
-		*v = new(GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing Database.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase: "%v"`, tn.TypeName)
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField.Datasource: %w", err)
+			}
+		}
 	}
+	return nil
 }
 
-func __marshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase(v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase) ([]byte, error) {
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField struct {
+	Typename string `json:"__typename"`
 
-	var typename string
-	switch v := (*v).(type) {
-	case *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile:
-		typename = "CloudFile"
+	Id string `json:"id"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer:
-		typename = "DatabaseServer"
+	Name string `json:"name"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile:
-		typename = "File"
+	Datasource json.RawMessage `json:"datasource"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector:
-		typename = "WebDataConnector"
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase: "%T"`, v)
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField.Datasource: %w", err)
+		}
 	}
+	return &retval, nil
 }
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField includes the requested fields of the GraphQL type CombinedSetField.
 // The GraphQL type's documentation follows.
 //
-// cloud file connection
-type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile struct {
+// GraphQL type for a combined set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html#Combine
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField struct {
 	Typename string `json:"__typename"`
 	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
 	Id string `json:"id"`
-	// Name shown in server and desktop clients
+	// Name shown in server
 	Name string `json:"name"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
-	// User modifiable description of this database
-	Description string `json:"description"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
 }
 
-// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetTypename() string {
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField) GetTypename() string {
 	return v.Typename
 }
 
-// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetId() string {
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField) GetId() string {
 	return v.Id
 }
 
-// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetName() string {
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField) GetName() string {
 	return v.Name
 }
 
-// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetConnectionType() string {
-	return v.ConnectionType
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
 }
 
-// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.Description, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetDescription() string {
-	return v.Description
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
-// The GraphQL type's documentation follows.
-//
-// database server connection
-type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer struct {
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField struct {
 	Typename string `json:"__typename"`
-	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+
 	Id string `json:"id"`
-	// Name shown in server and desktop clients
+
 	Name string `json:"name"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
-	// User modifiable description of this database
-	Description string `json:"description"`
-}
 
-// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetTypename() string {
-	return v.Typename
+	Datasource json.RawMessage `json:"datasource"`
 }
 
-// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetId() string {
-	return v.Id
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetName() string {
-	return v.Name
-}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField
 
-// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetConnectionType() string {
-	return v.ConnectionType
-}
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
 
-// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.Description, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetDescription() string {
-	return v.Description
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile includes the requested fields of the GraphQL type File.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField includes the requested fields of the GraphQL type DatasourceField.
 // The GraphQL type's documentation follows.
 //
-// file connection
-type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile struct {
+// GraphQL type for a data source field. Data source fields can only exist in embedded data sources which connect to a published data source. A data source field is an embedded data source's 'layered' representation of a field that already exists in the published data source and is mostly a copy of the field in the published data source. Data source fields can get their own descriptions and renames local to the embedded data source, but cannot otherwise be modified in the embedded data source.
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField struct {
 	Typename string `json:"__typename"`
 	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
 	Id string `json:"id"`
-	// Name shown in server and desktop clients
+	// Name shown in server
 	Name string `json:"name"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
-	// User modifiable description of this database
-	Description string `json:"description"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
 }
 
-// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetTypename() string {
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField) GetTypename() string {
 	return v.Typename
 }
 
-// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.Id, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetId() string {
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField) GetId() string {
 	return v.Id
 }
 
-// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.Name, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetName() string {
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField) GetName() string {
 	return v.Name
 }
 
-// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetConnectionType() string {
-	return v.ConnectionType
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
 }
 
-// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.Description, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetDescription() string {
-	return v.Description
-}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField) UnmarshalJSON(b []byte) error {
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
-// The GraphQL type's documentation follows.
-//
-// web data connector
-type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector struct {
-	Typename string `json:"__typename"`
-	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
-	Id string `json:"id"`
-	// Name shown in server and desktop clients
-	Name string `json:"name"`
-	// Connection type shortname
-	ConnectionType string `json:"connectionType"`
-	// User modifiable description of this database
-	Description string `json:"description"`
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetTypename() string {
-	return v.Typename
-}
+	var firstPass struct {
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField = v
 
-// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetId() string {
-	return v.Id
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetName() string {
-	return v.Name
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
 }
 
-// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetConnectionType() string {
-	return v.ConnectionType
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.Description, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetDescription() string {
-	return v.Description
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField includes the requested fields of the GraphQL interface Field.
+//
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField is implemented by the following types:
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField
 // The GraphQL type's documentation follows.
 //
-// Information about pagination in a connection
-type GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo struct {
-	// Indicates if there are more objects to fetch
-	HasNextPage bool `json:"hasNextPage"`
-	// Cursor to use in subsequent query to fetch next page of objects
-	EndCursor string `json:"endCursor"`
+// Base GraphQL type for a field
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField interface {
+	implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server
+	GetName() string
+	// GetDatasource returns the interface-field "datasource" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Data source that contains this field
+	GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField() {
+}
+
+func __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField(b []byte, v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "BinField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField)
+		return json.Unmarshal(b, *v)
+	case "CalculatedField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField)
+		return json.Unmarshal(b, *v)
+	case "ColumnField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField)
+		return json.Unmarshal(b, *v)
+	case "CombinedField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField)
+		return json.Unmarshal(b, *v)
+	case "CombinedSetField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField)
+		return json.Unmarshal(b, *v)
+	case "DatasourceField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField)
+		return json.Unmarshal(b, *v)
+	case "GroupField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField)
+		return json.Unmarshal(b, *v)
+	case "HierarchyField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField)
+		return json.Unmarshal(b, *v)
+	case "SetField":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Field.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField(v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField:
+		typename = "BinField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsBinField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField:
+		typename = "CalculatedField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCalculatedField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField:
+		typename = "ColumnField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsColumnField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField:
+		typename = "CombinedField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField:
+		typename = "CombinedSetField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsCombinedSetField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField:
+		typename = "DatasourceField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsDatasourceField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField:
+		typename = "GroupField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField:
+		typename = "HierarchyField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField:
+		typename = "SetField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsField: "%T"`, v)
+	}
+}
+
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource includes the requested fields of the GraphQL interface Datasource.
+//
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource is implemented by the following types:
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource
+// The GraphQL type's documentation follows.
+//
+// # Root GraphQL type for embedded and published data sources
+//
+// Data sources are a way to represent how Tableau Desktop and Tableau Server model and connect to data. Data sources can be published separately, as a published data source, or may be contained in a workbook as an embedded data source.
+//
+// See https://onlinehelp.tableau.com/current/server/en-us/datasource.htm
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource interface {
+	implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
 }
 
-// GetHasNextPage returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo) GetHasNextPage() bool {
-	return v.HasNextPage
-}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource() {
+}
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource) implementsGraphQLInterfaceGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource() {
+}
+
+func __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(b []byte, v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmbeddedDatasource":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource)
+		return json.Unmarshal(b, *v)
+	case "PublishedDatasource":
+		*v = new(GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Datasource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource:
+		typename = "EmbeddedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource:
+		typename = "PublishedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource: "%T"`, v)
+	}
+}
+
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourceEmbeddedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasourcePublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField includes the requested fields of the GraphQL type GroupField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a group field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_groups_creating.html
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsGroupField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField includes the requested fields of the GraphQL type HierarchyField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a hierarchy. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/qs_hierarchies.html
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsHierarchyField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField includes the requested fields of the GraphQL type SetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html
+type GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField) GetDatasource() GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField) __premarshalJSON() (*__premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField, error) {
+	var retval __premarshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCalculatedFieldLineageCalculatedFieldsConnectionNodesCalculatedFieldUpstreamFieldsSetField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetCalculatedFieldLineageCalculatedFieldsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetCalculatedFieldLineageCalculatedFieldsConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetCalculatedFieldLineageCalculatedFieldsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns GetCalculatedFieldLineageCalculatedFieldsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageCalculatedFieldsConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetCalculatedFieldLineageResponse is returned by GetCalculatedFieldLineage on success.
+type GetCalculatedFieldLineageResponse struct {
+	// Fetch CalculatedFields with support for pagination
+	CalculatedFieldsConnection GetCalculatedFieldLineageCalculatedFieldsConnection `json:"calculatedFieldsConnection"`
+}
+
+// GetCalculatedFieldsConnection returns GetCalculatedFieldLineageResponse.CalculatedFieldsConnection, and is useful for accessing the field via an interface.
+func (v *GetCalculatedFieldLineageResponse) GetCalculatedFieldsConnection() GetCalculatedFieldLineageCalculatedFieldsConnection {
+	return v.CalculatedFieldsConnection
+}
+
+// GetColumnLineageColumnsConnection includes the requested fields of the GraphQL type ColumnsConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Column
+type GetColumnLineageColumnsConnection struct {
+	// List of nodes
+	Nodes []GetColumnLineageColumnsConnectionNodesColumn `json:"nodes"`
+	// Information for pagination
+	PageInfo GetColumnLineageColumnsConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetColumnLineageColumnsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnection) GetNodes() []GetColumnLineageColumnsConnectionNodesColumn {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetColumnLineageColumnsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnection) GetPageInfo() GetColumnLineageColumnsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetColumnLineageColumnsConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetColumnLineageColumnsConnectionNodesColumn includes the requested fields of the GraphQL type Column.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a table column
+type GetColumnLineageColumnsConnectionNodesColumn struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name of column
+	Name string `json:"name"`
+	// The table that this column belongs to
+	Table GetColumnLineageColumnsConnectionNodesColumnTable `json:"-"`
+	// The column field that references this column
+	ReferencedByFields []GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField `json:"referencedByFields"`
+	// Fields downstream from the column
+	DownstreamFields []GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField `json:"-"`
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumn.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumn) GetId() string { return v.Id }
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumn.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumn) GetName() string { return v.Name }
+
+// GetTable returns GetColumnLineageColumnsConnectionNodesColumn.Table, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumn) GetTable() GetColumnLineageColumnsConnectionNodesColumnTable {
+	return v.Table
+}
+
+// GetReferencedByFields returns GetColumnLineageColumnsConnectionNodesColumn.ReferencedByFields, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumn) GetReferencedByFields() []GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField {
+	return v.ReferencedByFields
+}
+
+// GetDownstreamFields returns GetColumnLineageColumnsConnectionNodesColumn.DownstreamFields, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumn) GetDownstreamFields() []GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField {
+	return v.DownstreamFields
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumn) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumn
+		Table            json.RawMessage   `json:"table"`
+		DownstreamFields []json.RawMessage `json:"downstreamFields"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumn = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Table
+		src := firstPass.Table
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnTable(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumn.Table: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.DownstreamFields
+		src := firstPass.DownstreamFields
+		*dst = make(
+			[]GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumn.DownstreamFields: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumn struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Table json.RawMessage `json:"table"`
+
+	ReferencedByFields []GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField `json:"referencedByFields"`
+
+	DownstreamFields []json.RawMessage `json:"downstreamFields"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumn) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumn) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumn, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumn
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Table
+		src := v.Table
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnTable(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumn.Table: %w", err)
+		}
+	}
+	retval.ReferencedByFields = v.ReferencedByFields
+	{
+
+		dst := &retval.DownstreamFields
+		src := v.DownstreamFields
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetColumnLineageColumnsConnectionNodesColumn.DownstreamFields: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField includes the requested fields of the GraphQL type BinField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a binned continuous measure field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_bins.html
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField includes the requested fields of the GraphQL type CalculatedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField includes the requested fields of the GraphQL type CombinedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined field. Combined fields concatanate fields together into one string.
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField includes the requested fields of the GraphQL type CombinedSetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html#Combine
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField includes the requested fields of the GraphQL type DatasourceField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a data source field. Data source fields can only exist in embedded data sources which connect to a published data source. A data source field is an embedded data source's 'layered' representation of a field that already exists in the published data source and is mostly a copy of the field in the published data source. Data source fields can get their own descriptions and renames local to the embedded data source, but cannot otherwise be modified in the embedded data source.
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField includes the requested fields of the GraphQL interface Field.
+//
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField is implemented by the following types:
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField
+// The GraphQL type's documentation follows.
+//
+// Base GraphQL type for a field
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField interface {
+	implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server
+	GetName() string
+	// GetDatasource returns the interface-field "datasource" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Data source that contains this field
+	GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField() {
+}
+
+func __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField(b []byte, v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "BinField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField)
+		return json.Unmarshal(b, *v)
+	case "CalculatedField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField)
+		return json.Unmarshal(b, *v)
+	case "ColumnField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField)
+		return json.Unmarshal(b, *v)
+	case "CombinedField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField)
+		return json.Unmarshal(b, *v)
+	case "CombinedSetField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField)
+		return json.Unmarshal(b, *v)
+	case "DatasourceField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField)
+		return json.Unmarshal(b, *v)
+	case "GroupField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField)
+		return json.Unmarshal(b, *v)
+	case "HierarchyField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField)
+		return json.Unmarshal(b, *v)
+	case "SetField":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Field.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField(v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField:
+		typename = "BinField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsBinField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField:
+		typename = "CalculatedField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCalculatedField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField:
+		typename = "ColumnField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsColumnField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField:
+		typename = "CombinedField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField:
+		typename = "CombinedSetField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsCombinedSetField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField:
+		typename = "DatasourceField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsDatasourceField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField:
+		typename = "GroupField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField:
+		typename = "HierarchyField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField:
+		typename = "SetField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsField: "%T"`, v)
+	}
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource includes the requested fields of the GraphQL interface Datasource.
+//
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource is implemented by the following types:
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource
+// The GraphQL type's documentation follows.
+//
+// # Root GraphQL type for embedded and published data sources
+//
+// Data sources are a way to represent how Tableau Desktop and Tableau Server model and connect to data. Data sources can be published separately, as a published data source, or may be contained in a workbook as an embedded data source.
+//
+// See https://onlinehelp.tableau.com/current/server/en-us/datasource.htm
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource interface {
+	implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource() {
+}
+
+func __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(b []byte, v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmbeddedDatasource":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource)
+		return json.Unmarshal(b, *v)
+	case "PublishedDatasource":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Datasource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource:
+		typename = "EmbeddedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource:
+		typename = "PublishedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource: "%T"`, v)
+	}
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourceEmbeddedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasourcePublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField includes the requested fields of the GraphQL type GroupField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a group field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_groups_creating.html
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsGroupField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField includes the requested fields of the GraphQL type HierarchyField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a hierarchy. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/qs_hierarchies.html
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsHierarchyField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField includes the requested fields of the GraphQL type SetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html
+type GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnDownstreamFieldsSetField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField) GetDatasource() GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField) __premarshalJSON() (*__premarshalGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField, error) {
+	var retval __premarshalGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource includes the requested fields of the GraphQL interface Datasource.
+//
+// GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource is implemented by the following types:
+// GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource
+// GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource
+// The GraphQL type's documentation follows.
+//
+// # Root GraphQL type for embedded and published data sources
+//
+// Data sources are a way to represent how Tableau Desktop and Tableau Server model and connect to data. Data sources can be published separately, as a published data source, or may be contained in a workbook as an embedded data source.
+//
+// See https://onlinehelp.tableau.com/current/server/en-us/datasource.htm
+type GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource interface {
+	implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource() {
+}
+
+func __unmarshalGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource(b []byte, v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmbeddedDatasource":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource)
+		return json.Unmarshal(b, *v)
+	case "PublishedDatasource":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Datasource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource(v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource:
+		typename = "EmbeddedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource:
+		typename = "PublishedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasource: "%T"`, v)
+	}
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourceEmbeddedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnReferencedByFieldsColumnFieldDatasourcePublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnTable includes the requested fields of the GraphQL interface Table.
+//
+// GetColumnLineageColumnsConnectionNodesColumnTable is implemented by the following types:
+// GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable
+// GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable
+// GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable
+// The GraphQL type's documentation follows.
+//
+// table containing columns
+type GetColumnLineageColumnsConnectionNodesColumnTable interface {
+	implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnTable()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnTable() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnTable() {
+}
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable) implementsGraphQLInterfaceGetColumnLineageColumnsConnectionNodesColumnTable() {
+}
+
+func __unmarshalGetColumnLineageColumnsConnectionNodesColumnTable(b []byte, v *GetColumnLineageColumnsConnectionNodesColumnTable) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CustomSQLTable":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable)
+		return json.Unmarshal(b, *v)
+	case "DatabaseTable":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable)
+		return json.Unmarshal(b, *v)
+	case "VirtualConnectionTable":
+		*v = new(GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Table.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetColumnLineageColumnsConnectionNodesColumnTable: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetColumnLineageColumnsConnectionNodesColumnTable(v *GetColumnLineageColumnsConnectionNodesColumnTable) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable:
+		typename = "CustomSQLTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable:
+		typename = "DatabaseTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable:
+		typename = "VirtualConnectionTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetColumnLineageColumnsConnectionNodesColumnTable: "%T"`, v)
+	}
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable includes the requested fields of the GraphQL type CustomSQLTable.
+// The GraphQL type's documentation follows.
+//
+// table that represents the result of evaluating a custom SQL query. These "tables" are owned by the Tableau data source (embedded or published) which contains the SQL query, so they only exist within that data source.
+type GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable) GetId() string { return v.Id }
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableCustomSQLTable) GetName() string {
+	return v.Name
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable) GetId() string { return v.Id }
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable includes the requested fields of the GraphQL type VirtualConnectionTable.
+// The GraphQL type's documentation follows.
+//
+// A table in a virtual connection.
+// *Available in Tableau Cloud March 2022 / Server 2022.1 and later.*
+type GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable.Id, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable.Name, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionNodesColumnTableVirtualConnectionTable) GetName() string {
+	return v.Name
+}
+
+// GetColumnLineageColumnsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetColumnLineageColumnsConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetColumnLineageColumnsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns GetColumnLineageColumnsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageColumnsConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetColumnLineageResponse is returned by GetColumnLineage on success.
+type GetColumnLineageResponse struct {
+	// Fetch Columns with support for pagination
+	ColumnsConnection GetColumnLineageColumnsConnection `json:"columnsConnection"`
+}
+
+// GetColumnsConnection returns GetColumnLineageResponse.ColumnsConnection, and is useful for accessing the field via an interface.
+func (v *GetColumnLineageResponse) GetColumnsConnection() GetColumnLineageColumnsConnection {
+	return v.ColumnsConnection
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnection includes the requested fields of the GraphQL type CustomSQLTablesConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for CustomSQLTable
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnection struct {
+	// List of nodes
+	Nodes []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable `json:"nodes"`
+	// Information for pagination
+	PageInfo GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnection) GetNodes() []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnection) GetPageInfo() GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable includes the requested fields of the GraphQL type CustomSQLTable.
+// The GraphQL type's documentation follows.
+//
+// table that represents the result of evaluating a custom SQL query. These "tables" are owned by the Tableau data source (embedded or published) which contains the SQL query, so they only exist within that data source.
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// A custom SQL tables is always embedded in Tableau content
+	IsEmbedded bool `json:"isEmbedded"`
+	// Database this query is executed on
+	Database GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase `json:"-"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// User modifiable description of this table
+	Description string `json:"description"`
+	// Text of the query
+	Query string `json:"query"`
+	// True if the query is unsupported by Tableau Catalog, in which case lineage may be incomplete
+	IsUnsupportedCustomSql bool `json:"isUnsupportedCustomSql"`
+	// Columns contained in this table
+	Columns []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn `json:"columns"`
+	// Actual tables that this query references.
+	Tables []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable `json:"tables"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetName() string {
+	return v.Name
+}
+
+// GetIsEmbedded returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.IsEmbedded, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetIsEmbedded() bool {
+	return v.IsEmbedded
+}
+
+// GetDatabase returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Database, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetDatabase() GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase {
+	return v.Database
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Description, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetDescription() string {
+	return v.Description
+}
+
+// GetQuery returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Query, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetQuery() string {
+	return v.Query
+}
+
+// GetIsUnsupportedCustomSql returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.IsUnsupportedCustomSql, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetIsUnsupportedCustomSql() bool {
+	return v.IsUnsupportedCustomSql
+}
+
+// GetColumns returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Columns, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetColumns() []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn {
+	return v.Columns
+}
+
+// GetTables returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Tables, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) GetTables() []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable {
+	return v.Tables
+}
+
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable
+		Database json.RawMessage `json:"database"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Database
+		src := firstPass.Database
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Database: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsEmbedded bool `json:"isEmbedded"`
+
+	Database json.RawMessage `json:"database"`
+
+	ConnectionType string `json:"connectionType"`
+
+	Description string `json:"description"`
+
+	Query string `json:"query"`
+
+	IsUnsupportedCustomSql bool `json:"isUnsupportedCustomSql"`
+
+	Columns []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn `json:"columns"`
+
+	Tables []GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable `json:"tables"`
+}
+
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable) __premarshalJSON() (*__premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable, error) {
+	var retval __premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.IsEmbedded = v.IsEmbedded
+	{
+
+		dst := &retval.Database
+		src := v.Database
+		var err error
+		*dst, err = __marshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable.Database: %w", err)
+		}
+	}
+	retval.ConnectionType = v.ConnectionType
+	retval.Description = v.Description
+	retval.Query = v.Query
+	retval.IsUnsupportedCustomSql = v.IsUnsupportedCustomSql
+	retval.Columns = v.Columns
+	retval.Tables = v.Tables
+	return &retval, nil
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn includes the requested fields of the GraphQL type Column.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a table column
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name of column
+	Name string `json:"name"`
+	// Remote type on the database. Types correspond to OLEDB types here: https://referencesource.microsoft.com/#system.data/System/Data/OleDb/OLEDB_Enum.cs,364
+	RemoteType RemoteType `json:"remoteType"`
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn) GetName() string {
+	return v.Name
+}
+
+// GetRemoteType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn.RemoteType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableColumnsColumn) GetRemoteType() RemoteType {
+	return v.RemoteType
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase is implemented by the following types:
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase interface {
+	implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+	// GetDescription returns the interface-field "description" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// User modifiable description of this database
+	GetDescription() string
+}
+
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase() {
+}
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase() {
+}
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase() {
+}
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase() {
+}
+
+func __unmarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase(b []byte, v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase(v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabase: "%T"`, v)
+	}
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// User modifiable description of this database
+	Description string `json:"description"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetId() string {
+	return v.Id
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile.Description, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseCloudFile) GetDescription() string {
+	return v.Description
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// User modifiable description of this database
+	Description string `json:"description"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetId() string {
+	return v.Id
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer.Description, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseDatabaseServer) GetDescription() string {
+	return v.Description
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// User modifiable description of this database
+	Description string `json:"description"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetId() string {
+	return v.Id
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile.Description, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseFile) GetDescription() string {
+	return v.Description
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// User modifiable description of this database
+	Description string `json:"description"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetId() string {
+	return v.Id
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector.Description, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableDatabaseWebDataConnector) GetDescription() string {
+	return v.Description
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+	// The database to which this table belongs
+	Database GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase `json:"-"`
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetSchema returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDatabase returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable.Database, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) GetDatabase() GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase {
+	return v.Database
+}
+
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable
+		Database json.RawMessage `json:"database"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Database
+		src := firstPass.Database
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable.Database: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Schema string `json:"schema"`
+
+	FullName string `json:"fullName"`
+
+	ConnectionType string `json:"connectionType"`
+
+	Database json.RawMessage `json:"database"`
+}
+
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable) __premarshalJSON() (*__premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable, error) {
+	var retval __premarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Schema = v.Schema
+	retval.FullName = v.FullName
+	retval.ConnectionType = v.ConnectionType
+	{
+
+		dst := &retval.Database
+		src := v.Database
+		var err error
+		*dst, err = __marshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTable.Database: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase is implemented by the following types:
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase interface {
+	implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+}
+
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase() {
+}
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase() {
+}
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase() {
+}
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase() {
+}
+
+func __unmarshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase(b []byte, v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase(v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabase: "%T"`, v)
+	}
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTableTablesDatabaseTableDatabaseWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetCustomSQLTablesDefinitionsResponse is returned by GetCustomSQLTablesDefinitions on success.
+type GetCustomSQLTablesDefinitionsResponse struct {
+	// Fetch CustomSQLTables with support for pagination
+	CustomSQLTablesConnection GetCustomSQLTablesDefinitionsCustomSQLTablesConnection `json:"customSQLTablesConnection"`
+}
+
+// GetCustomSQLTablesConnection returns GetCustomSQLTablesDefinitionsResponse.CustomSQLTablesConnection, and is useful for accessing the field via an interface.
+func (v *GetCustomSQLTablesDefinitionsResponse) GetCustomSQLTablesConnection() GetCustomSQLTablesDefinitionsCustomSQLTablesConnection {
+	return v.CustomSQLTablesConnection
+}
+
+// GetDashboardsDashboardsConnection includes the requested fields of the GraphQL type DashboardsConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Dashboard
+type GetDashboardsDashboardsConnection struct {
+	// List of nodes
+	Nodes []GetDashboardsDashboardsConnectionNodesDashboard `json:"nodes"`
+	// Information for pagination
+	PageInfo GetDashboardsDashboardsConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetDashboardsDashboardsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnection) GetNodes() []GetDashboardsDashboardsConnectionNodesDashboard {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetDashboardsDashboardsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnection) GetPageInfo() GetDashboardsDashboardsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetDashboardsDashboardsConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetDashboardsDashboardsConnectionNodesDashboard includes the requested fields of the GraphQL type Dashboard.
+// The GraphQL type's documentation follows.
+//
+// dashboard contained in a published workbook.
+type GetDashboardsDashboardsConnectionNodesDashboard struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server (Blank if worksheet is hidden in Workbook)
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Server path to dashboard
+	Path string `json:"path"`
+	// Time the dashboard was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time the dashboard was updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The workbook that contains this view
+	Workbook GetDashboardsDashboardsConnectionNodesDashboardWorkbook `json:"workbook"`
+	// Sheets referenced by this dashboard
+	Sheets []GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet `json:"sheets"`
+	// Tags associated with the view
+	Tags []GetDashboardsDashboardsConnectionNodesDashboardTagsTag `json:"tags"`
+	// The tables that are upstream of this dashboard
+	UpstreamTables []GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable `json:"-"`
+	// The data sources that are upstream of this dashboard
+	UpstreamDatasources []GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource `json:"-"`
+}
+
+// GetTypename returns GetDashboardsDashboardsConnectionNodesDashboard.Typename, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetTypename() string { return v.Typename }
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboard.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetId() string { return v.Id }
+
+// GetLuid returns GetDashboardsDashboardsConnectionNodesDashboard.Luid, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetLuid() string { return v.Luid }
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboard.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetName() string { return v.Name }
+
+// GetPath returns GetDashboardsDashboardsConnectionNodesDashboard.Path, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetPath() string { return v.Path }
+
+// GetCreatedAt returns GetDashboardsDashboardsConnectionNodesDashboard.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns GetDashboardsDashboardsConnectionNodesDashboard.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetWorkbook returns GetDashboardsDashboardsConnectionNodesDashboard.Workbook, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetWorkbook() GetDashboardsDashboardsConnectionNodesDashboardWorkbook {
+	return v.Workbook
+}
+
+// GetSheets returns GetDashboardsDashboardsConnectionNodesDashboard.Sheets, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetSheets() []GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet {
+	return v.Sheets
+}
+
+// GetTags returns GetDashboardsDashboardsConnectionNodesDashboard.Tags, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetTags() []GetDashboardsDashboardsConnectionNodesDashboardTagsTag {
+	return v.Tags
+}
+
+// GetUpstreamTables returns GetDashboardsDashboardsConnectionNodesDashboard.UpstreamTables, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetUpstreamTables() []GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable {
+	return v.UpstreamTables
+}
+
+// GetUpstreamDatasources returns GetDashboardsDashboardsConnectionNodesDashboard.UpstreamDatasources, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) GetUpstreamDatasources() []GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource {
+	return v.UpstreamDatasources
+}
+
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetDashboardsDashboardsConnectionNodesDashboard
+		UpstreamTables      []json.RawMessage `json:"upstreamTables"`
+		UpstreamDatasources []json.RawMessage `json:"upstreamDatasources"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetDashboardsDashboardsConnectionNodesDashboard = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.UpstreamTables
+		src := firstPass.UpstreamTables
+		*dst = make(
+			[]GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetDashboardsDashboardsConnectionNodesDashboard.UpstreamTables: %w", err)
+				}
+			}
+		}
+	}
+
+	{
+		dst := &v.UpstreamDatasources
+		src := firstPass.UpstreamDatasources
+		*dst = make(
+			[]GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetDashboardsDashboardsConnectionNodesDashboard.UpstreamDatasources: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetDashboardsDashboardsConnectionNodesDashboard struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Luid string `json:"luid"`
+
+	Name string `json:"name"`
+
+	Path string `json:"path"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Workbook GetDashboardsDashboardsConnectionNodesDashboardWorkbook `json:"workbook"`
+
+	Sheets []GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet `json:"sheets"`
+
+	Tags []GetDashboardsDashboardsConnectionNodesDashboardTagsTag `json:"tags"`
+
+	UpstreamTables []json.RawMessage `json:"upstreamTables"`
+
+	UpstreamDatasources []json.RawMessage `json:"upstreamDatasources"`
+}
+
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetDashboardsDashboardsConnectionNodesDashboard) __premarshalJSON() (*__premarshalGetDashboardsDashboardsConnectionNodesDashboard, error) {
+	var retval __premarshalGetDashboardsDashboardsConnectionNodesDashboard
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Luid = v.Luid
+	retval.Name = v.Name
+	retval.Path = v.Path
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.Workbook = v.Workbook
+	retval.Sheets = v.Sheets
+	retval.Tags = v.Tags
+	{
+
+		dst := &retval.UpstreamTables
+		src := v.UpstreamTables
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetDashboardsDashboardsConnectionNodesDashboard.UpstreamTables: %w", err)
+			}
+		}
+	}
+	{
+
+		dst := &retval.UpstreamDatasources
+		src := v.UpstreamDatasources
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetDashboardsDashboardsConnectionNodesDashboard.UpstreamDatasources: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet includes the requested fields of the GraphQL type Sheet.
+// The GraphQL type's documentation follows.
+//
+// sheet contained in a published workbook.
+type GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server (Blank if worksheet is hidden in Workbook)
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet) GetId() string { return v.Id }
+
+// GetLuid returns GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet.Luid, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet) GetLuid() string { return v.Luid }
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardSheetsSheet) GetName() string { return v.Name }
+
+// GetDashboardsDashboardsConnectionNodesDashboardTagsTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// tag associated with content items
+type GetDashboardsDashboardsConnectionNodesDashboardTagsTag struct {
+	// Unique identifier used by the metadata API.
+	Id string `json:"id"`
+	// The name of the tag
+	Name string `json:"name"`
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardTagsTag) GetId() string { return v.Id }
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardTagsTag) GetName() string { return v.Name }
+
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource includes the requested fields of the GraphQL interface Datasource.
+//
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource is implemented by the following types:
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource
+// The GraphQL type's documentation follows.
+//
+// # Root GraphQL type for embedded and published data sources
+//
+// Data sources are a way to represent how Tableau Desktop and Tableau Server model and connect to data. Data sources can be published separately, as a published data source, or may be contained in a workbook as an embedded data source.
+//
+// See https://onlinehelp.tableau.com/current/server/en-us/datasource.htm
+type GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource interface {
+	implementsGraphQLInterfaceGetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource) implementsGraphQLInterfaceGetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource() {
+}
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource) implementsGraphQLInterfaceGetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource() {
+}
+
+func __unmarshalGetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource(b []byte, v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmbeddedDatasource":
+		*v = new(GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource)
+		return json.Unmarshal(b, *v)
+	case "PublishedDatasource":
+		*v = new(GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Datasource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource(v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource:
+		typename = "EmbeddedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource:
+		typename = "PublishedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesDatasource: "%T"`, v)
+	}
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesEmbeddedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamDatasourcesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable includes the requested fields of the GraphQL type CustomSQLTable.
+// The GraphQL type's documentation follows.
+//
+// table that represents the result of evaluating a custom SQL query. These "tables" are owned by the Tableau data source (embedded or published) which contains the SQL query, so they only exist within that data source.
+type GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable) GetName() string {
+	return v.Name
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetSchema returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetConnectionType returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable includes the requested fields of the GraphQL interface Table.
+//
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable is implemented by the following types:
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable
+// The GraphQL type's documentation follows.
+//
+// table containing columns
+type GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable interface {
+	implementsGraphQLInterfaceGetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable) implementsGraphQLInterfaceGetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable() {
+}
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable) implementsGraphQLInterfaceGetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable() {
+}
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable) implementsGraphQLInterfaceGetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable() {
+}
+
+func __unmarshalGetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable(b []byte, v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CustomSQLTable":
+		*v = new(GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable)
+		return json.Unmarshal(b, *v)
+	case "DatabaseTable":
+		*v = new(GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable)
+		return json.Unmarshal(b, *v)
+	case "VirtualConnectionTable":
+		*v = new(GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Table.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable(v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable:
+		typename = "CustomSQLTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesCustomSQLTable
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable:
+		typename = "DatabaseTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesDatabaseTable
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable:
+		typename = "VirtualConnectionTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesTable: "%T"`, v)
+	}
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable includes the requested fields of the GraphQL type VirtualConnectionTable.
+// The GraphQL type's documentation follows.
+//
+// A table in a virtual connection.
+// *Available in Tableau Cloud March 2022 / Server 2022.1 and later.*
+type GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardUpstreamTablesVirtualConnectionTable) GetName() string {
+	return v.Name
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardWorkbook includes the requested fields of the GraphQL type Workbook.
+// The GraphQL type's documentation follows.
+//
+// Workbooks are used to package up Tableau visualizations (which are called "sheets" in the Metadata API) and data models (which are called "embedded data sources" when they are owned by a workbook).
+type GetDashboardsDashboardsConnectionNodesDashboardWorkbook struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// The name of the project in which the workbook is visible and usable.
+	ProjectName string `json:"projectName"`
+	// Uri of the workbook
+	Uri string `json:"uri"`
+	// User who owns this workbook
+	Owner GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser `json:"owner"`
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardWorkbook.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbook) GetId() string { return v.Id }
+
+// GetLuid returns GetDashboardsDashboardsConnectionNodesDashboardWorkbook.Luid, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbook) GetLuid() string { return v.Luid }
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardWorkbook.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbook) GetName() string { return v.Name }
+
+// GetProjectName returns GetDashboardsDashboardsConnectionNodesDashboardWorkbook.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbook) GetProjectName() string {
+	return v.ProjectName
+}
+
+// GetUri returns GetDashboardsDashboardsConnectionNodesDashboardWorkbook.Uri, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbook) GetUri() string { return v.Uri }
+
+// GetOwner returns GetDashboardsDashboardsConnectionNodesDashboardWorkbook.Owner, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbook) GetOwner() GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser {
+	return v.Owner
+}
+
+// GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser includes the requested fields of the GraphQL type TableauUser.
+// The GraphQL type's documentation follows.
+//
+// User on a site on Tableau server
+type GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser struct {
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Display name of this user
+	Name string `json:"name"`
+	// Username of this user
+	Username string `json:"username"`
+	// Email address of this user
+	Email string `json:"email"`
+}
+
+// GetId returns GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser.Id, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser.Luid, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser.Name, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser) GetName() string {
+	return v.Name
+}
+
+// GetUsername returns GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser.Username, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser) GetUsername() string {
+	return v.Username
+}
+
+// GetEmail returns GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser.Email, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionNodesDashboardWorkbookOwnerTableauUser) GetEmail() string {
+	return v.Email
+}
+
+// GetDashboardsDashboardsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetDashboardsDashboardsConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetDashboardsDashboardsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns GetDashboardsDashboardsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetDashboardsDashboardsConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetDashboardsResponse is returned by GetDashboards on success.
+type GetDashboardsResponse struct {
+	// Fetch Dashboards with support for pagination
+	DashboardsConnection GetDashboardsDashboardsConnection `json:"dashboardsConnection"`
+}
+
+// GetDashboardsConnection returns GetDashboardsResponse.DashboardsConnection, and is useful for accessing the field via an interface.
+func (v *GetDashboardsResponse) GetDashboardsConnection() GetDashboardsDashboardsConnection {
+	return v.DashboardsConnection
+}
+
+// GetDatabaseTableCountsDatabasesConnection includes the requested fields of the GraphQL type DatabasesConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Database
+type GetDatabaseTableCountsDatabasesConnection struct {
+	// List of nodes
+	Nodes []GetDatabaseTableCountsDatabasesConnectionNodesDatabase `json:"-"`
+	// Information for pagination
+	PageInfo GetDatabaseTableCountsDatabasesConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetDatabaseTableCountsDatabasesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnection) GetNodes() []GetDatabaseTableCountsDatabasesConnectionNodesDatabase {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetDatabaseTableCountsDatabasesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnection) GetPageInfo() GetDatabaseTableCountsDatabasesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetDatabaseTableCountsDatabasesConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnection) GetTotalCount() int { return v.TotalCount }
+
+func (v *GetDatabaseTableCountsDatabasesConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetDatabaseTableCountsDatabasesConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetDatabaseTableCountsDatabasesConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]GetDatabaseTableCountsDatabasesConnectionNodesDatabase,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetDatabaseTableCountsDatabasesConnectionNodesDatabase(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetDatabaseTableCountsDatabasesConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetDatabaseTableCountsDatabasesConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
+
+	PageInfo GetDatabaseTableCountsDatabasesConnectionPageInfo `json:"pageInfo"`
+
+	TotalCount int `json:"totalCount"`
+}
+
+func (v *GetDatabaseTableCountsDatabasesConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetDatabaseTableCountsDatabasesConnection) __premarshalJSON() (*__premarshalGetDatabaseTableCountsDatabasesConnection, error) {
+	var retval __premarshalGetDatabaseTableCountsDatabasesConnection
+
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetDatabaseTableCountsDatabasesConnectionNodesDatabase(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetDatabaseTableCountsDatabasesConnection.Nodes: %w", err)
+			}
+		}
+	}
+	retval.PageInfo = v.PageInfo
+	retval.TotalCount = v.TotalCount
+	return &retval, nil
+}
+
+// GetDatabaseTableCountsDatabasesConnectionNodesCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetDatabaseTableCountsDatabasesConnectionNodesCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// Tables belonging to this database
+	TablesConnection GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection `json:"tablesConnection"`
+}
+
+// GetTypename returns GetDatabaseTableCountsDatabasesConnectionNodesCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDatabaseTableCountsDatabasesConnectionNodesCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesCloudFile) GetId() string { return v.Id }
+
+// GetConnectionType returns GetDatabaseTableCountsDatabasesConnectionNodesCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetTablesConnection returns GetDatabaseTableCountsDatabasesConnectionNodesCloudFile.TablesConnection, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesCloudFile) GetTablesConnection() GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection {
+	return v.TablesConnection
+}
+
+// GetDatabaseTableCountsDatabasesConnectionNodesDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetDatabaseTableCountsDatabasesConnectionNodesDatabase is implemented by the following types:
+// GetDatabaseTableCountsDatabasesConnectionNodesCloudFile
+// GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer
+// GetDatabaseTableCountsDatabasesConnectionNodesFile
+// GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetDatabaseTableCountsDatabasesConnectionNodesDatabase interface {
+	implementsGraphQLInterfaceGetDatabaseTableCountsDatabasesConnectionNodesDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+	// GetTablesConnection returns the interface-field "tablesConnection" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Tables belonging to this database
+	GetTablesConnection() GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection
+}
+
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesCloudFile) implementsGraphQLInterfaceGetDatabaseTableCountsDatabasesConnectionNodesDatabase() {
+}
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer) implementsGraphQLInterfaceGetDatabaseTableCountsDatabasesConnectionNodesDatabase() {
+}
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesFile) implementsGraphQLInterfaceGetDatabaseTableCountsDatabasesConnectionNodesDatabase() {
+}
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector) implementsGraphQLInterfaceGetDatabaseTableCountsDatabasesConnectionNodesDatabase() {
+}
+
+func __unmarshalGetDatabaseTableCountsDatabasesConnectionNodesDatabase(b []byte, v *GetDatabaseTableCountsDatabasesConnectionNodesDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetDatabaseTableCountsDatabasesConnectionNodesCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetDatabaseTableCountsDatabasesConnectionNodesFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetDatabaseTableCountsDatabasesConnectionNodesDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetDatabaseTableCountsDatabasesConnectionNodesDatabase(v *GetDatabaseTableCountsDatabasesConnectionNodesDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetDatabaseTableCountsDatabasesConnectionNodesCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDatabaseTableCountsDatabasesConnectionNodesCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDatabaseTableCountsDatabasesConnectionNodesFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDatabaseTableCountsDatabasesConnectionNodesFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetDatabaseTableCountsDatabasesConnectionNodesDatabase: "%T"`, v)
+	}
+}
+
+// GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// Tables belonging to this database
+	TablesConnection GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection `json:"tablesConnection"`
+}
+
+// GetTypename returns GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer) GetId() string { return v.Id }
+
+// GetConnectionType returns GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetTablesConnection returns GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer.TablesConnection, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesDatabaseServer) GetTablesConnection() GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection {
+	return v.TablesConnection
+}
+
+// GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection includes the requested fields of the GraphQL type DatabaseTablesConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for DatabaseTable
+type GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection struct {
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetTotalCount returns GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// GetDatabaseTableCountsDatabasesConnectionNodesFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetDatabaseTableCountsDatabasesConnectionNodesFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// Tables belonging to this database
+	TablesConnection GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection `json:"tablesConnection"`
+}
+
+// GetTypename returns GetDatabaseTableCountsDatabasesConnectionNodesFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesFile) GetTypename() string { return v.Typename }
+
+// GetId returns GetDatabaseTableCountsDatabasesConnectionNodesFile.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesFile) GetId() string { return v.Id }
+
+// GetConnectionType returns GetDatabaseTableCountsDatabasesConnectionNodesFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetTablesConnection returns GetDatabaseTableCountsDatabasesConnectionNodesFile.TablesConnection, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesFile) GetTablesConnection() GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection {
+	return v.TablesConnection
+}
+
+// GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// Tables belonging to this database
+	TablesConnection GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection `json:"tablesConnection"`
+}
+
+// GetTypename returns GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector) GetId() string { return v.Id }
+
+// GetConnectionType returns GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetTablesConnection returns GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector.TablesConnection, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionNodesWebDataConnector) GetTablesConnection() GetDatabaseTableCountsDatabasesConnectionNodesDatabaseTablesConnection {
+	return v.TablesConnection
+}
+
+// GetDatabaseTableCountsDatabasesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetDatabaseTableCountsDatabasesConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetDatabaseTableCountsDatabasesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns GetDatabaseTableCountsDatabasesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsDatabasesConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetDatabaseTableCountsResponse is returned by GetDatabaseTableCounts on success.
+type GetDatabaseTableCountsResponse struct {
+	// Fetch Databases with support for pagination
+	DatabasesConnection GetDatabaseTableCountsDatabasesConnection `json:"databasesConnection"`
+}
+
+// GetDatabasesConnection returns GetDatabaseTableCountsResponse.DatabasesConnection, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTableCountsResponse) GetDatabasesConnection() GetDatabaseTableCountsDatabasesConnection {
+	return v.DatabasesConnection
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnection includes the requested fields of the GraphQL type DatabaseTablesConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for DatabaseTable
+type GetDatabaseTablesDefinitionsDatabaseTablesConnection struct {
+	// List of nodes
+	Nodes []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable `json:"nodes"`
+	// Information for pagination
+	PageInfo GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetDatabaseTablesDefinitionsDatabaseTablesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnection) GetNodes() []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetDatabaseTablesDefinitionsDatabaseTablesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnection) GetPageInfo() GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetDatabaseTablesDefinitionsDatabaseTablesConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// True if this table is embedded in Tableau content
+	IsEmbedded bool `json:"isEmbedded"`
+	// The database to which this table belongs
+	Database GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase `json:"-"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// The name of the project in which the table is visible. Will be empty if the table is not in a project.
+	ProjectName string `json:"projectName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+	// User modifiable description of this table
+	Description string `json:"description"`
+	// True if this table contains an active data quality certification
+	IsCertified bool `json:"isCertified"`
+	// True if the table has an active data quality warning
+	HasActiveWarning bool `json:"hasActiveWarning"`
+	// Columns contained in this table
+	Columns []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn `json:"columns"`
+}
+
+// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetIsEmbedded returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.IsEmbedded, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetIsEmbedded() bool {
+	return v.IsEmbedded
+}
+
+// GetDatabase returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Database, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetDatabase() GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase {
+	return v.Database
+}
+
+// GetSchema returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetProjectName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetProjectName() string {
+	return v.ProjectName
+}
+
+// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Description, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetDescription() string {
+	return v.Description
+}
+
+// GetIsCertified returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.IsCertified, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetIsCertified() bool {
+	return v.IsCertified
+}
+
+// GetHasActiveWarning returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.HasActiveWarning, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetHasActiveWarning() bool {
+	return v.HasActiveWarning
+}
+
+// GetColumns returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Columns, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) GetColumns() []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn {
+	return v.Columns
+}
+
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable
+		Database json.RawMessage `json:"database"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Database
+		src := firstPass.Database
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Database: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsEmbedded bool `json:"isEmbedded"`
+
+	Database json.RawMessage `json:"database"`
+
+	Schema string `json:"schema"`
+
+	FullName string `json:"fullName"`
+
+	ProjectName string `json:"projectName"`
+
+	ConnectionType string `json:"connectionType"`
+
+	Description string `json:"description"`
+
+	IsCertified bool `json:"isCertified"`
+
+	HasActiveWarning bool `json:"hasActiveWarning"`
+
+	Columns []GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn `json:"columns"`
+}
+
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) __premarshalJSON() (*__premarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable, error) {
+	var retval __premarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.IsEmbedded = v.IsEmbedded
+	{
+
+		dst := &retval.Database
+		src := v.Database
+		var err error
+		*dst, err = __marshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable.Database: %w", err)
+		}
+	}
+	retval.Schema = v.Schema
+	retval.FullName = v.FullName
+	retval.ProjectName = v.ProjectName
+	retval.ConnectionType = v.ConnectionType
+	retval.Description = v.Description
+	retval.IsCertified = v.IsCertified
+	retval.HasActiveWarning = v.HasActiveWarning
+	retval.Columns = v.Columns
+	return &retval, nil
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn includes the requested fields of the GraphQL type Column.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a table column
+type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name of column
+	Name string `json:"name"`
+	// Remote type on the database. Types correspond to OLEDB types here: https://referencesource.microsoft.com/#system.data/System/Data/OleDb/OLEDB_Enum.cs,364
+	RemoteType RemoteType `json:"remoteType"`
+}
+
+// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn.Name, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn) GetName() string {
+	return v.Name
+}
+
+// GetRemoteType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn.RemoteType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableColumnsColumn) GetRemoteType() RemoteType {
+	return v.RemoteType
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase is implemented by the following types:
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase interface {
+	implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+	// GetDescription returns the interface-field "description" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// User modifiable description of this database
+	GetDescription() string
+}
+
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase() {
+}
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase() {
+}
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase() {
+}
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase() {
+}
+
+func __unmarshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase(b []byte, v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase(v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabase: "%T"`, v)
+	}
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// User modifiable description of this database
+	Description string `json:"description"`
+}
+
+// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile.Description, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseCloudFile) GetDescription() string {
+	return v.Description
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// User modifiable description of this database
+	Description string `json:"description"`
+}
+
+// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer.Description, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseDatabaseServer) GetDescription() string {
+	return v.Description
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// User modifiable description of this database
+	Description string `json:"description"`
+}
+
+// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.Name, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile.Description, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseFile) GetDescription() string {
+	return v.Description
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+	// User modifiable description of this database
+	Description string `json:"description"`
+}
+
+// GetTypename returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDescription returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector.Description, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTableDatabaseWebDataConnector) GetDescription() string {
+	return v.Description
+}
+
+// GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetDatabaseTablesDefinitionsResponse is returned by GetDatabaseTablesDefinitions on success.
+type GetDatabaseTablesDefinitionsResponse struct {
+	// Fetch DatabaseTables with support for pagination
+	DatabaseTablesConnection GetDatabaseTablesDefinitionsDatabaseTablesConnection `json:"databaseTablesConnection"`
+}
+
+// GetDatabaseTablesConnection returns GetDatabaseTablesDefinitionsResponse.DatabaseTablesConnection, and is useful for accessing the field via an interface.
+func (v *GetDatabaseTablesDefinitionsResponse) GetDatabaseTablesConnection() GetDatabaseTablesDefinitionsDatabaseTablesConnection {
+	return v.DatabaseTablesConnection
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnection includes the requested fields of the GraphQL type EmbeddedDatasourcesConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for EmbeddedDatasource
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnection struct {
+	// List of nodes
+	Nodes []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource `json:"nodes"`
+	// Information for pagination
+	PageInfo GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnection) GetNodes() []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnection) GetPageInfo() GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Time the datasource was created. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time the datasource was last updated. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// True if datasource contains extracted data
+	HasExtracts bool `json:"hasExtracts"`
+	// True if the datasource contains unsupported custom SQL, in which case lineage may be incomplete
+	ContainsUnsupportedCustomSql bool `json:"containsUnsupportedCustomSql"`
+	// Workbook that contains these embedded datasources
+	Workbook GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook `json:"workbook"`
+	// Parent published data sources of this embedded data source
+	ParentPublishedDatasources []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource `json:"parentPublishedDatasources"`
+	// Tables upstream from this data source
+	UpstreamTables []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable `json:"upstreamTables"`
+	// Datasources upstream from this data source
+	UpstreamDatasources []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource `json:"upstreamDatasources"`
+	// Fields, usually measures or dimensions, contained in the data source
+	Fields []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField `json:"-"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetCreatedAt returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetHasExtracts returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.HasExtracts, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetHasExtracts() bool {
+	return v.HasExtracts
+}
+
+// GetContainsUnsupportedCustomSql returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.ContainsUnsupportedCustomSql, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetContainsUnsupportedCustomSql() bool {
+	return v.ContainsUnsupportedCustomSql
+}
+
+// GetWorkbook returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.Workbook, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetWorkbook() GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook {
+	return v.Workbook
+}
+
+// GetParentPublishedDatasources returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.ParentPublishedDatasources, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetParentPublishedDatasources() []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource {
+	return v.ParentPublishedDatasources
+}
+
+// GetUpstreamTables returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.UpstreamTables, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetUpstreamTables() []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable {
+	return v.UpstreamTables
+}
+
+// GetUpstreamDatasources returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.UpstreamDatasources, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetUpstreamDatasources() []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource {
+	return v.UpstreamDatasources
+}
+
+// GetFields returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.Fields, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) GetFields() []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField {
+	return v.Fields
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource
+		Fields []json.RawMessage `json:"fields"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Fields
+		src := firstPass.Fields
+		*dst = make(
+			[]GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.Fields: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	HasExtracts bool `json:"hasExtracts"`
+
+	ContainsUnsupportedCustomSql bool `json:"containsUnsupportedCustomSql"`
+
+	Workbook GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook `json:"workbook"`
+
+	ParentPublishedDatasources []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource `json:"parentPublishedDatasources"`
+
+	UpstreamTables []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable `json:"upstreamTables"`
+
+	UpstreamDatasources []GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource `json:"upstreamDatasources"`
+
+	Fields []json.RawMessage `json:"fields"`
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource) __premarshalJSON() (*__premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource, error) {
+	var retval __premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.HasExtracts = v.HasExtracts
+	retval.ContainsUnsupportedCustomSql = v.ContainsUnsupportedCustomSql
+	retval.Workbook = v.Workbook
+	retval.ParentPublishedDatasources = v.ParentPublishedDatasources
+	retval.UpstreamTables = v.UpstreamTables
+	retval.UpstreamDatasources = v.UpstreamDatasources
+	{
+
+		dst := &retval.Fields
+		src := v.Fields
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource.Fields: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField includes the requested fields of the GraphQL type BinField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a binned continuous measure field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_bins.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField includes the requested fields of the GraphQL type CalculatedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+	// Type of the data in the field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_typesandroles_datatypes.html
+	DataType FieldDataType `json:"dataType"`
+	// Role of the field: 'dimension', 'measure' or 'unknown'
+	Role FieldRole `json:"role"`
+	// Formula of the calculated field
+	Formula string `json:"formula"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetDataType returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.DataType, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetDataType() FieldDataType {
+	return v.DataType
+}
+
+// GetRole returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.Role, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetRole() FieldRole {
+	return v.Role
+}
+
+// GetFormula returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField.Formula, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) GetFormula() string {
+	return v.Formula
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+	// Type of the data in the field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_typesandroles_datatypes.html
+	DataType FieldDataType `json:"dataType"`
+	// Role of the field: 'dimension', 'measure' or 'unknown'
+	Role FieldRole `json:"role"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetDataType returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.DataType, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetDataType() FieldDataType {
+	return v.DataType
+}
+
+// GetRole returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField.Role, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) GetRole() FieldRole {
+	return v.Role
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField includes the requested fields of the GraphQL type CombinedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined field. Combined fields concatanate fields together into one string.
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField includes the requested fields of the GraphQL type CombinedSetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html#Combine
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField includes the requested fields of the GraphQL type DatasourceField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a data source field. Data source fields can only exist in embedded data sources which connect to a published data source. A data source field is an embedded data source's 'layered' representation of a field that already exists in the published data source and is mostly a copy of the field in the published data source. Data source fields can get their own descriptions and renames local to the embedded data source, but cannot otherwise be modified in the embedded data source.
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+	// Reference to a field from a published data source. This property only exists on Fields that are in an embedded data source with a connection to a published data source.
+	RemoteField GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField `json:"-"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetRemoteField returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.RemoteField, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) GetRemoteField() GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField {
+	return v.RemoteField
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField
+		RemoteField json.RawMessage `json:"remoteField"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RemoteField
+		src := firstPass.RemoteField
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.RemoteField: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+
+	FolderName string `json:"folderName"`
+
+	IsHidden bool `json:"isHidden"`
+
+	RemoteField json.RawMessage `json:"remoteField"`
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) __premarshalJSON() (*__premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField, error) {
+	var retval __premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	retval.FullyQualifiedName = v.FullyQualifiedName
+	retval.FolderName = v.FolderName
+	retval.IsHidden = v.IsHidden
+	{
+
+		dst := &retval.RemoteField
+		src := v.RemoteField
+		var err error
+		*dst, err = __marshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField.RemoteField: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField includes the requested fields of the GraphQL interface Field.
+//
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField is implemented by the following types:
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField
+// The GraphQL type's documentation follows.
+//
+// Base GraphQL type for a field
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField interface {
+	implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server
+	GetName() string
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+
+func __unmarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField(b []byte, v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "BinField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField)
+		return json.Unmarshal(b, *v)
+	case "CalculatedField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField)
+		return json.Unmarshal(b, *v)
+	case "ColumnField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField)
+		return json.Unmarshal(b, *v)
+	case "CombinedField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField)
+		return json.Unmarshal(b, *v)
+	case "CombinedSetField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField)
+		return json.Unmarshal(b, *v)
+	case "DatasourceField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField)
+		return json.Unmarshal(b, *v)
+	case "GroupField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField)
+		return json.Unmarshal(b, *v)
+	case "HierarchyField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField)
+		return json.Unmarshal(b, *v)
+	case "SetField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Field.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField(v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField:
+		typename = "BinField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField:
+		typename = "CalculatedField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField:
+		typename = "ColumnField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField:
+		typename = "CombinedField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField:
+		typename = "CombinedSetField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField:
+		typename = "DatasourceField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField:
+		typename = "GroupField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField:
+		typename = "HierarchyField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField:
+		typename = "SetField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteField: "%T"`, v)
+	}
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField includes the requested fields of the GraphQL type BinField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a binned continuous measure field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_bins.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldBinField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField includes the requested fields of the GraphQL type CalculatedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldColumnField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField includes the requested fields of the GraphQL type CombinedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined field. Combined fields concatanate fields together into one string.
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField includes the requested fields of the GraphQL type CombinedSetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html#Combine
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField includes the requested fields of the GraphQL type DatasourceField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a data source field. Data source fields can only exist in embedded data sources which connect to a published data source. A data source field is an embedded data source's 'layered' representation of a field that already exists in the published data source and is mostly a copy of the field in the published data source. Data source fields can get their own descriptions and renames local to the embedded data source, but cannot otherwise be modified in the embedded data source.
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField includes the requested fields of the GraphQL type GroupField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a group field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_groups_creating.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldGroupField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField includes the requested fields of the GraphQL type HierarchyField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a hierarchy. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/qs_hierarchies.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField includes the requested fields of the GraphQL type SetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceFieldRemoteFieldSetField) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField includes the requested fields of the GraphQL interface Field.
+//
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField is implemented by the following types:
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField
+// The GraphQL type's documentation follows.
+//
+// Base GraphQL type for a field
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField interface {
+	implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server
+	GetName() string
+	// GetDescription returns the interface-field "description" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Description of field shown in server and desktop clients
+	GetDescription() string
+	// GetFullyQualifiedName returns the interface-field "fullyQualifiedName" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name internally used to uniquely identify fields
+	GetFullyQualifiedName() string
+	// GetFolderName returns the interface-field "folderName" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	GetFolderName() string
+	// GetIsHidden returns the interface-field "isHidden" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// True if the field is hidden
+	GetIsHidden() bool
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField() {
+}
+
+func __unmarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField(b []byte, v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "BinField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField)
+		return json.Unmarshal(b, *v)
+	case "CalculatedField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField)
+		return json.Unmarshal(b, *v)
+	case "ColumnField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField)
+		return json.Unmarshal(b, *v)
+	case "CombinedField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField)
+		return json.Unmarshal(b, *v)
+	case "CombinedSetField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField)
+		return json.Unmarshal(b, *v)
+	case "DatasourceField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField)
+		return json.Unmarshal(b, *v)
+	case "GroupField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField)
+		return json.Unmarshal(b, *v)
+	case "HierarchyField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField)
+		return json.Unmarshal(b, *v)
+	case "SetField":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Field.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField(v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField:
+		typename = "BinField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsBinField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField:
+		typename = "CalculatedField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCalculatedField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField:
+		typename = "ColumnField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsColumnField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField:
+		typename = "CombinedField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField:
+		typename = "CombinedSetField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsCombinedSetField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField:
+		typename = "DatasourceField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsDatasourceField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField:
+		typename = "GroupField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField:
+		typename = "HierarchyField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField:
+		typename = "SetField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsField: "%T"`, v)
+	}
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField includes the requested fields of the GraphQL type GroupField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a group field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_groups_creating.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsGroupField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField includes the requested fields of the GraphQL type HierarchyField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a hierarchy. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/qs_hierarchies.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsHierarchyField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField includes the requested fields of the GraphQL type SetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField.Description, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceFieldsSetField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource.Luid, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceParentPublishedDatasourcesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource.Luid, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamDatasourcesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+	// The database to which this table belongs
+	Database GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase `json:"-"`
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetSchema returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetConnectionType returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDatabase returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable.Database, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) GetDatabase() GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase {
+	return v.Database
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable
+		Database json.RawMessage `json:"database"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Database
+		src := firstPass.Database
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable.Database: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Schema string `json:"schema"`
+
+	FullName string `json:"fullName"`
+
+	ConnectionType string `json:"connectionType"`
+
+	Database json.RawMessage `json:"database"`
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable) __premarshalJSON() (*__premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable, error) {
+	var retval __premarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Schema = v.Schema
+	retval.FullName = v.FullName
+	retval.ConnectionType = v.ConnectionType
+	{
+
+		dst := &retval.Database
+		src := v.Database
+		var err error
+		*dst, err = __marshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTable.Database: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase is implemented by the following types:
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase interface {
+	implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+}
+
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase() {
+}
+
+func __unmarshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase(b []byte, v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase(v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabase: "%T"`, v)
+	}
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook includes the requested fields of the GraphQL type Workbook.
+// The GraphQL type's documentation follows.
+//
+// Workbooks are used to package up Tableau visualizations (which are called "sheets" in the Metadata API) and data models (which are called "embedded data sources" when they are owned by a workbook).
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// The name of the project in which the workbook is visible and usable.
+	ProjectName string `json:"projectName"`
+	// User who owns this workbook
+	Owner GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser `json:"owner"`
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook.Luid, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook) GetName() string {
+	return v.Name
+}
+
+// GetProjectName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook) GetProjectName() string {
+	return v.ProjectName
+}
+
+// GetOwner returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook.Owner, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbook) GetOwner() GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser {
+	return v.Owner
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser includes the requested fields of the GraphQL type TableauUser.
+// The GraphQL type's documentation follows.
+//
+// User on a site on Tableau server
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser struct {
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Display name of this user
+	Name string `json:"name"`
+	// Username of this user
+	Username string `json:"username"`
+	// Email address of this user
+	Email string `json:"email"`
+}
+
+// GetId returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser.Id, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser.Luid, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser.Name, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser) GetName() string {
+	return v.Name
+}
+
+// GetUsername returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser.Username, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser) GetUsername() string {
+	return v.Username
+}
+
+// GetEmail returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser.Email, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasourceWorkbookOwnerTableauUser) GetEmail() string {
+	return v.Email
+}
+
+// GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetEmbeddedDatasourcesResponse is returned by GetEmbeddedDatasources on success.
+type GetEmbeddedDatasourcesResponse struct {
+	// Fetch EmbeddedDatasources with support for pagination
+	EmbeddedDatasourcesConnection GetEmbeddedDatasourcesEmbeddedDatasourcesConnection `json:"embeddedDatasourcesConnection"`
+}
+
+// GetEmbeddedDatasourcesConnection returns GetEmbeddedDatasourcesResponse.EmbeddedDatasourcesConnection, and is useful for accessing the field via an interface.
+func (v *GetEmbeddedDatasourcesResponse) GetEmbeddedDatasourcesConnection() GetEmbeddedDatasourcesEmbeddedDatasourcesConnection {
+	return v.EmbeddedDatasourcesConnection
+}
+
+// GetFlowVersionsFlowsConnection includes the requested fields of the GraphQL type FlowsConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Flow
+type GetFlowVersionsFlowsConnection struct {
+	// List of nodes
+	Nodes []GetFlowVersionsFlowsConnectionNodesFlow `json:"nodes"`
+	// Information for pagination
+	PageInfo GetFlowVersionsFlowsConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetFlowVersionsFlowsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnection) GetNodes() []GetFlowVersionsFlowsConnectionNodesFlow {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetFlowVersionsFlowsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnection) GetPageInfo() GetFlowVersionsFlowsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetFlowVersionsFlowsConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetFlowVersionsFlowsConnectionNodesFlow includes the requested fields of the GraphQL type Flow.
+// The GraphQL type's documentation follows.
+//
+// Flows are used to prepare data, which can include aggregation, cleaning, preprocessing, etc.
+type GetFlowVersionsFlowsConnectionNodesFlow struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// The name of the project in which the flow is visible and usable
+	ProjectName string `json:"projectName"`
+	// Time the flow was updated. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetFlowVersionsFlowsConnectionNodesFlow.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnectionNodesFlow) GetId() string { return v.Id }
+
+// GetName returns GetFlowVersionsFlowsConnectionNodesFlow.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnectionNodesFlow) GetName() string { return v.Name }
+
+// GetProjectName returns GetFlowVersionsFlowsConnectionNodesFlow.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnectionNodesFlow) GetProjectName() string { return v.ProjectName }
+
+// GetUpdatedAt returns GetFlowVersionsFlowsConnectionNodesFlow.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnectionNodesFlow) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetFlowVersionsFlowsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetFlowVersionsFlowsConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetFlowVersionsFlowsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns GetFlowVersionsFlowsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsFlowsConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetFlowVersionsResponse is returned by GetFlowVersions on success.
+type GetFlowVersionsResponse struct {
+	// Fetch Flows with support for pagination
+	FlowsConnection GetFlowVersionsFlowsConnection `json:"flowsConnection"`
+}
+
+// GetFlowsConnection returns GetFlowVersionsResponse.FlowsConnection, and is useful for accessing the field via an interface.
+func (v *GetFlowVersionsResponse) GetFlowsConnection() GetFlowVersionsFlowsConnection {
+	return v.FlowsConnection
+}
+
+// GetFlowsFlowsConnection includes the requested fields of the GraphQL type FlowsConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Flow
+type GetFlowsFlowsConnection struct {
+	// List of nodes
+	Nodes []GetFlowsFlowsConnectionNodesFlow `json:"nodes"`
+	// Information for pagination
+	PageInfo GetFlowsFlowsConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetFlowsFlowsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnection) GetNodes() []GetFlowsFlowsConnectionNodesFlow { return v.Nodes }
+
+// GetPageInfo returns GetFlowsFlowsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnection) GetPageInfo() GetFlowsFlowsConnectionPageInfo { return v.PageInfo }
+
+// GetTotalCount returns GetFlowsFlowsConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetFlowsFlowsConnectionNodesFlow includes the requested fields of the GraphQL type Flow.
+// The GraphQL type's documentation follows.
+//
+// Flows are used to prepare data, which can include aggregation, cleaning, preprocessing, etc.
+type GetFlowsFlowsConnectionNodesFlow struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Description of the flow
+	Description string `json:"description"`
+	// The name of the project in which the flow is visible and usable
+	ProjectName string `json:"projectName"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Uri string `json:"uri"`
+	// Time the flow was created. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time the flow was updated. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// User who owns this flow
+	Owner GetFlowsFlowsConnectionNodesFlowOwnerTableauUser `json:"owner"`
+	// Tags associated with a flow
+	Tags []GetFlowsFlowsConnectionNodesFlowTagsTag `json:"tags"`
+	// Tables that are upstream from this flow.
+	UpstreamTables []GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable `json:"upstreamTables"`
+	// Tables that are downstream from this flow.
+	DownstreamTables []GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable `json:"downstreamTables"`
+	// Datasources that are upstream from this flow.
+	UpstreamDatasources []GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource `json:"upstreamDatasources"`
+	// Published Data Sources that are downstream from this flow.
+	DownstreamDatasources []GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource `json:"downstreamDatasources"`
+	// Output steps for this flow
+	OutputSteps []GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep `json:"outputSteps"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlow.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetTypename() string { return v.Typename }
+
+// GetId returns GetFlowsFlowsConnectionNodesFlow.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetId() string { return v.Id }
+
+// GetLuid returns GetFlowsFlowsConnectionNodesFlow.Luid, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetLuid() string { return v.Luid }
+
+// GetName returns GetFlowsFlowsConnectionNodesFlow.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetName() string { return v.Name }
+
+// GetDescription returns GetFlowsFlowsConnectionNodesFlow.Description, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetDescription() string { return v.Description }
+
+// GetProjectName returns GetFlowsFlowsConnectionNodesFlow.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetProjectName() string { return v.ProjectName }
+
+// GetUri returns GetFlowsFlowsConnectionNodesFlow.Uri, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetUri() string { return v.Uri }
+
+// GetCreatedAt returns GetFlowsFlowsConnectionNodesFlow.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetFlowsFlowsConnectionNodesFlow.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetOwner returns GetFlowsFlowsConnectionNodesFlow.Owner, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetOwner() GetFlowsFlowsConnectionNodesFlowOwnerTableauUser {
+	return v.Owner
+}
+
+// GetTags returns GetFlowsFlowsConnectionNodesFlow.Tags, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetTags() []GetFlowsFlowsConnectionNodesFlowTagsTag {
+	return v.Tags
+}
+
+// GetUpstreamTables returns GetFlowsFlowsConnectionNodesFlow.UpstreamTables, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetUpstreamTables() []GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable {
+	return v.UpstreamTables
+}
+
+// GetDownstreamTables returns GetFlowsFlowsConnectionNodesFlow.DownstreamTables, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetDownstreamTables() []GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable {
+	return v.DownstreamTables
+}
+
+// GetUpstreamDatasources returns GetFlowsFlowsConnectionNodesFlow.UpstreamDatasources, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetUpstreamDatasources() []GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource {
+	return v.UpstreamDatasources
+}
+
+// GetDownstreamDatasources returns GetFlowsFlowsConnectionNodesFlow.DownstreamDatasources, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetDownstreamDatasources() []GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource {
+	return v.DownstreamDatasources
+}
+
+// GetOutputSteps returns GetFlowsFlowsConnectionNodesFlow.OutputSteps, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlow) GetOutputSteps() []GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep {
+	return v.OutputSteps
+}
+
+// GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource.Luid, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+	// The database to which this table belongs
+	Database GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase `json:"-"`
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) GetId() string { return v.Id }
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetSchema returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDatabase returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable.Database, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) GetDatabase() GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase {
+	return v.Database
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable
+		Database json.RawMessage `json:"database"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Database
+		src := firstPass.Database
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable.Database: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Schema string `json:"schema"`
+
+	FullName string `json:"fullName"`
+
+	ConnectionType string `json:"connectionType"`
+
+	Database json.RawMessage `json:"database"`
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable) __premarshalJSON() (*__premarshalGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable, error) {
+	var retval __premarshalGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Schema = v.Schema
+	retval.FullName = v.FullName
+	retval.ConnectionType = v.ConnectionType
+	{
+
+		dst := &retval.Database
+		src := v.Database
+		var err error
+		*dst, err = __marshalGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable.Database: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase is implemented by the following types:
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase interface {
+	implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase() {
+}
+
+func __unmarshalGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase(b []byte, v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase(v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabase: "%T"`, v)
+	}
+}
+
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep includes the requested fields of the GraphQL type FlowOutputStep.
+// The GraphQL type's documentation follows.
+//
+// flow output step
+type GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep struct {
+	// Unique identifier used by the metadata API
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Fields output by this step
+	OutputFields []GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField `json:"-"`
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep) GetId() string { return v.Id }
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep) GetName() string { return v.Name }
+
+// GetOutputFields returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep.OutputFields, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep) GetOutputFields() []GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField {
+	return v.OutputFields
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep
+		OutputFields []json.RawMessage `json:"outputFields"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.OutputFields
+		src := firstPass.OutputFields
+		*dst = make(
+			[]GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep.OutputFields: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	OutputFields []json.RawMessage `json:"outputFields"`
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep) __premarshalJSON() (*__premarshalGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep, error) {
+	var retval __premarshalGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.OutputFields
+		src := v.OutputFields
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep.OutputFields: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField includes the requested fields of the GraphQL type FlowColumnOutputField.
+// The GraphQL type's documentation follows.
+//
+// Column output field implementation
+type GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField) GetName() string {
+	return v.Name
+}
+
+// GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField includes the requested fields of the GraphQL type FlowFieldOutputField.
+// The GraphQL type's documentation follows.
+//
+// Field output field implementation
+type GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField) GetName() string {
+	return v.Name
+}
+
+// GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField includes the requested fields of the GraphQL interface FlowOutputField.
+//
+// GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField is implemented by the following types:
+// GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField
+// GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField
+// The GraphQL type's documentation follows.
+//
+// wrapper for an output field contained in a published flow.
+type GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField interface {
+	implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField() {
+}
+func (v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField() {
+}
+
+func __unmarshalGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField(b []byte, v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "FlowColumnOutputField":
+		*v = new(GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField)
+		return json.Unmarshal(b, *v)
+	case "FlowFieldOutputField":
+		*v = new(GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing FlowOutputField.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField(v *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField:
+		typename = "FlowColumnOutputField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField:
+		typename = "FlowFieldOutputField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowFieldOutputField
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField: "%T"`, v)
+	}
+}
+
+// GetFlowsFlowsConnectionNodesFlowOwnerTableauUser includes the requested fields of the GraphQL type TableauUser.
+// The GraphQL type's documentation follows.
+//
+// User on a site on Tableau server
+type GetFlowsFlowsConnectionNodesFlowOwnerTableauUser struct {
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Display name of this user
+	Name string `json:"name"`
+	// Username of this user
+	Username string `json:"username"`
+	// Email address of this user
+	Email string `json:"email"`
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowOwnerTableauUser.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOwnerTableauUser) GetId() string { return v.Id }
+
+// GetLuid returns GetFlowsFlowsConnectionNodesFlowOwnerTableauUser.Luid, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOwnerTableauUser) GetLuid() string { return v.Luid }
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowOwnerTableauUser.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOwnerTableauUser) GetName() string { return v.Name }
+
+// GetUsername returns GetFlowsFlowsConnectionNodesFlowOwnerTableauUser.Username, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOwnerTableauUser) GetUsername() string { return v.Username }
+
+// GetEmail returns GetFlowsFlowsConnectionNodesFlowOwnerTableauUser.Email, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowOwnerTableauUser) GetEmail() string { return v.Email }
+
+// GetFlowsFlowsConnectionNodesFlowTagsTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// tag associated with content items
+type GetFlowsFlowsConnectionNodesFlowTagsTag struct {
+	// Unique identifier used by the metadata API.
+	Id string `json:"id"`
+	// The name of the tag
+	Name string `json:"name"`
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowTagsTag) GetId() string { return v.Id }
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowTagsTag) GetName() string { return v.Name }
+
+// GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource.Luid, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+	// The database to which this table belongs
+	Database GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase `json:"-"`
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) GetId() string { return v.Id }
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) GetName() string { return v.Name }
+
+// GetSchema returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDatabase returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable.Database, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) GetDatabase() GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase {
+	return v.Database
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable
+		Database json.RawMessage `json:"database"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Database
+		src := firstPass.Database
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable.Database: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Schema string `json:"schema"`
+
+	FullName string `json:"fullName"`
+
+	ConnectionType string `json:"connectionType"`
+
+	Database json.RawMessage `json:"database"`
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable) __premarshalJSON() (*__premarshalGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable, error) {
+	var retval __premarshalGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Schema = v.Schema
+	retval.FullName = v.FullName
+	retval.ConnectionType = v.ConnectionType
+	{
+
+		dst := &retval.Database
+		src := v.Database
+		var err error
+		*dst, err = __marshalGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable.Database: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase is implemented by the following types:
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase interface {
+	implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+}
+
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase() {
+}
+
+func __unmarshalGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase(b []byte, v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase(v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabase: "%T"`, v)
+	}
+}
+
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetFlowsFlowsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetFlowsFlowsConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetFlowsFlowsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns GetFlowsFlowsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetFlowsFlowsConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetFlowsResponse is returned by GetFlows on success.
+type GetFlowsResponse struct {
+	// Fetch Flows with support for pagination
+	FlowsConnection GetFlowsFlowsConnection `json:"flowsConnection"`
+}
+
+// GetFlowsConnection returns GetFlowsResponse.FlowsConnection, and is useful for accessing the field via an interface.
+func (v *GetFlowsResponse) GetFlowsConnection() GetFlowsFlowsConnection { return v.FlowsConnection }
+
+// GetPublishedDatasourceVersionsPublishedDatasourcesConnection includes the requested fields of the GraphQL type PublishedDatasourcesConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for PublishedDatasource
+type GetPublishedDatasourceVersionsPublishedDatasourcesConnection struct {
+	// List of nodes
+	Nodes []GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource `json:"nodes"`
+	// Information for pagination
+	PageInfo GetPublishedDatasourceVersionsPublishedDatasourcesConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetPublishedDatasourceVersionsPublishedDatasourcesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnection) GetNodes() []GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetPublishedDatasourceVersionsPublishedDatasourcesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnection) GetPageInfo() GetPublishedDatasourceVersionsPublishedDatasourcesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetPublishedDatasourceVersionsPublishedDatasourcesConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// The name of the project that contains this published data source.
+	ProjectName string `json:"projectName"`
+	// Time the datasource was last updated. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetProjectName returns GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource) GetProjectName() string {
+	return v.ProjectName
+}
+
+// GetUpdatedAt returns GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnectionNodesPublishedDatasource) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetPublishedDatasourceVersionsPublishedDatasourcesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetPublishedDatasourceVersionsPublishedDatasourcesConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetPublishedDatasourceVersionsPublishedDatasourcesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns GetPublishedDatasourceVersionsPublishedDatasourcesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsPublishedDatasourcesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetPublishedDatasourceVersionsResponse is returned by GetPublishedDatasourceVersions on success.
+type GetPublishedDatasourceVersionsResponse struct {
+	// Fetch PublishedDatasources with support for pagination
+	PublishedDatasourcesConnection GetPublishedDatasourceVersionsPublishedDatasourcesConnection `json:"publishedDatasourcesConnection"`
+}
+
+// GetPublishedDatasourcesConnection returns GetPublishedDatasourceVersionsResponse.PublishedDatasourcesConnection, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourceVersionsResponse) GetPublishedDatasourcesConnection() GetPublishedDatasourceVersionsPublishedDatasourcesConnection {
+	return v.PublishedDatasourcesConnection
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnection includes the requested fields of the GraphQL type PublishedDatasourcesConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for PublishedDatasource
+type GetPublishedDatasourcesPublishedDatasourcesConnection struct {
+	// List of nodes
+	Nodes []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource `json:"nodes"`
+	// Information for pagination
+	PageInfo GetPublishedDatasourcesPublishedDatasourcesConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetPublishedDatasourcesPublishedDatasourcesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnection) GetNodes() []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetPublishedDatasourcesPublishedDatasourcesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnection) GetPageInfo() GetPublishedDatasourcesPublishedDatasourcesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetPublishedDatasourcesPublishedDatasourcesConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Description of the datasource
+	Description string `json:"description"`
+	// The name of the project that contains this published data source.
+	ProjectName string `json:"projectName"`
+	// Uri of the datasource
+	Uri string `json:"uri"`
+	// Time the datasource was created. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time the datasource was last updated. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// True if datasource contains extracted data
+	HasExtracts bool `json:"hasExtracts"`
+	// True if the datasource contains unsupported custom SQL, in which case lineage may be incomplete
+	ContainsUnsupportedCustomSql bool `json:"containsUnsupportedCustomSql"`
+	// True if this data source contains an active data quality certification
+	IsCertified bool `json:"isCertified"`
+	// The data quality certifications on a published datasource
+	DataQualityCertifications []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification `json:"dataQualityCertifications"`
+	// User who owns this data source
+	Owner GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser `json:"owner"`
+	// Tags associated with the published datasource
+	Tags []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag `json:"tags"`
+	// Tables upstream from this data source
+	UpstreamTables []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable `json:"upstreamTables"`
+	// Datasources upstream from this data source
+	UpstreamDatasources []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource `json:"upstreamDatasources"`
+	// Fields usable in workbooks connected to this data source
+	Fields []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField `json:"-"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Luid, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetDescription() string {
+	return v.Description
+}
+
+// GetProjectName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetProjectName() string {
+	return v.ProjectName
+}
+
+// GetUri returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Uri, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetUri() string {
+	return v.Uri
+}
+
+// GetCreatedAt returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetHasExtracts returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.HasExtracts, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetHasExtracts() bool {
+	return v.HasExtracts
+}
+
+// GetContainsUnsupportedCustomSql returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.ContainsUnsupportedCustomSql, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetContainsUnsupportedCustomSql() bool {
+	return v.ContainsUnsupportedCustomSql
+}
+
+// GetIsCertified returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.IsCertified, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetIsCertified() bool {
+	return v.IsCertified
+}
+
+// GetDataQualityCertifications returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.DataQualityCertifications, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetDataQualityCertifications() []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification {
+	return v.DataQualityCertifications
+}
+
+// GetOwner returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Owner, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetOwner() GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser {
+	return v.Owner
+}
+
+// GetTags returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Tags, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetTags() []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag {
+	return v.Tags
+}
+
+// GetUpstreamTables returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.UpstreamTables, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetUpstreamTables() []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable {
+	return v.UpstreamTables
+}
+
+// GetUpstreamDatasources returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.UpstreamDatasources, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetUpstreamDatasources() []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource {
+	return v.UpstreamDatasources
+}
+
+// GetFields returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Fields, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) GetFields() []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField {
+	return v.Fields
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource
+		Fields []json.RawMessage `json:"fields"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Fields
+		src := firstPass.Fields
+		*dst = make(
+			[]GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Fields: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Luid string `json:"luid"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	ProjectName string `json:"projectName"`
+
+	Uri string `json:"uri"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	HasExtracts bool `json:"hasExtracts"`
+
+	ContainsUnsupportedCustomSql bool `json:"containsUnsupportedCustomSql"`
+
+	IsCertified bool `json:"isCertified"`
+
+	DataQualityCertifications []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification `json:"dataQualityCertifications"`
+
+	Owner GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser `json:"owner"`
+
+	Tags []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag `json:"tags"`
+
+	UpstreamTables []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable `json:"upstreamTables"`
+
+	UpstreamDatasources []GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource `json:"upstreamDatasources"`
+
+	Fields []json.RawMessage `json:"fields"`
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource) __premarshalJSON() (*__premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource, error) {
+	var retval __premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Luid = v.Luid
+	retval.Name = v.Name
+	retval.Description = v.Description
+	retval.ProjectName = v.ProjectName
+	retval.Uri = v.Uri
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.HasExtracts = v.HasExtracts
+	retval.ContainsUnsupportedCustomSql = v.ContainsUnsupportedCustomSql
+	retval.IsCertified = v.IsCertified
+	retval.DataQualityCertifications = v.DataQualityCertifications
+	retval.Owner = v.Owner
+	retval.Tags = v.Tags
+	retval.UpstreamTables = v.UpstreamTables
+	retval.UpstreamDatasources = v.UpstreamDatasources
+	{
+
+		dst := &retval.Fields
+		src := v.Fields
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource.Fields: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification includes the requested fields of the GraphQL type DataQualityCertification.
+// The GraphQL type's documentation follows.
+//
+// data quality certification associated with a content item
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification struct {
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// True if the data quality certification is active
+	IsActive bool `json:"isActive"`
+	// Message of the data quality certification
+	Message string `json:"message"`
+	// Name of the user who last updated this data quality certification
+	AuthorDisplayName string `json:"authorDisplayName"`
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification) GetId() string {
+	return v.Id
+}
+
+// GetIsActive returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification.IsActive, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification) GetIsActive() bool {
+	return v.IsActive
+}
+
+// GetMessage returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification.Message, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification) GetMessage() string {
+	return v.Message
+}
+
+// GetAuthorDisplayName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification.AuthorDisplayName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceDataQualityCertificationsDataQualityCertification) GetAuthorDisplayName() string {
+	return v.AuthorDisplayName
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField includes the requested fields of the GraphQL type BinField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a binned continuous measure field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_bins.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField includes the requested fields of the GraphQL type CalculatedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+	// Type of the data in the field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_typesandroles_datatypes.html
+	DataType FieldDataType `json:"dataType"`
+	// Role of the field: 'dimension', 'measure' or 'unknown'
+	Role FieldRole `json:"role"`
+	// Formula of the calculated field
+	Formula string `json:"formula"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetDataType returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.DataType, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetDataType() FieldDataType {
+	return v.DataType
+}
+
+// GetRole returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.Role, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetRole() FieldRole {
+	return v.Role
+}
+
+// GetFormula returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField.Formula, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) GetFormula() string {
+	return v.Formula
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+	// Type of the data in the field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_typesandroles_datatypes.html
+	DataType FieldDataType `json:"dataType"`
+	// Role of the field: 'dimension', 'measure' or 'unknown'
+	Role FieldRole `json:"role"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetDataType returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.DataType, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetDataType() FieldDataType {
+	return v.DataType
+}
+
+// GetRole returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField.Role, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) GetRole() FieldRole {
+	return v.Role
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField includes the requested fields of the GraphQL type CombinedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined field. Combined fields concatanate fields together into one string.
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField includes the requested fields of the GraphQL type CombinedSetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html#Combine
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField includes the requested fields of the GraphQL type DatasourceField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a data source field. Data source fields can only exist in embedded data sources which connect to a published data source. A data source field is an embedded data source's 'layered' representation of a field that already exists in the published data source and is mostly a copy of the field in the published data source. Data source fields can get their own descriptions and renames local to the embedded data source, but cannot otherwise be modified in the embedded data source.
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+	// Reference to a field from a published data source. This property only exists on Fields that are in an embedded data source with a connection to a published data source.
+	RemoteField GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField `json:"-"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetRemoteField returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.RemoteField, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) GetRemoteField() GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField {
+	return v.RemoteField
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField
+		RemoteField json.RawMessage `json:"remoteField"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RemoteField
+		src := firstPass.RemoteField
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.RemoteField: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+
+	FolderName string `json:"folderName"`
+
+	IsHidden bool `json:"isHidden"`
+
+	RemoteField json.RawMessage `json:"remoteField"`
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) __premarshalJSON() (*__premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField, error) {
+	var retval __premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	retval.FullyQualifiedName = v.FullyQualifiedName
+	retval.FolderName = v.FolderName
+	retval.IsHidden = v.IsHidden
+	{
+
+		dst := &retval.RemoteField
+		src := v.RemoteField
+		var err error
+		*dst, err = __marshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField.RemoteField: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField includes the requested fields of the GraphQL interface Field.
+//
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField is implemented by the following types:
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField
+// The GraphQL type's documentation follows.
+//
+// Base GraphQL type for a field
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField interface {
+	implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server
+	GetName() string
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField() {
+}
+
+func __unmarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField(b []byte, v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "BinField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField)
+		return json.Unmarshal(b, *v)
+	case "CalculatedField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField)
+		return json.Unmarshal(b, *v)
+	case "ColumnField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField)
+		return json.Unmarshal(b, *v)
+	case "CombinedField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField)
+		return json.Unmarshal(b, *v)
+	case "CombinedSetField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField)
+		return json.Unmarshal(b, *v)
+	case "DatasourceField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField)
+		return json.Unmarshal(b, *v)
+	case "GroupField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField)
+		return json.Unmarshal(b, *v)
+	case "HierarchyField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField)
+		return json.Unmarshal(b, *v)
+	case "SetField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Field.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField(v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField:
+		typename = "BinField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField:
+		typename = "CalculatedField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField:
+		typename = "ColumnField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField:
+		typename = "CombinedField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField:
+		typename = "CombinedSetField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField:
+		typename = "DatasourceField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField:
+		typename = "GroupField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField:
+		typename = "HierarchyField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField:
+		typename = "SetField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteField: "%T"`, v)
+	}
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField includes the requested fields of the GraphQL type BinField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a binned continuous measure field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_bins.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldBinField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField includes the requested fields of the GraphQL type CalculatedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCalculatedField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldColumnField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField includes the requested fields of the GraphQL type CombinedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined field. Combined fields concatanate fields together into one string.
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField includes the requested fields of the GraphQL type CombinedSetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html#Combine
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldCombinedSetField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField includes the requested fields of the GraphQL type DatasourceField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a data source field. Data source fields can only exist in embedded data sources which connect to a published data source. A data source field is an embedded data source's 'layered' representation of a field that already exists in the published data source and is mostly a copy of the field in the published data source. Data source fields can get their own descriptions and renames local to the embedded data source, but cannot otherwise be modified in the embedded data source.
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldDatasourceField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField includes the requested fields of the GraphQL type GroupField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a group field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_groups_creating.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldGroupField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField includes the requested fields of the GraphQL type HierarchyField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a hierarchy. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/qs_hierarchies.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldHierarchyField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField includes the requested fields of the GraphQL type SetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceFieldRemoteFieldSetField) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField includes the requested fields of the GraphQL interface Field.
+//
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField is implemented by the following types:
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField
+// The GraphQL type's documentation follows.
+//
+// Base GraphQL type for a field
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField interface {
+	implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server
+	GetName() string
+	// GetDescription returns the interface-field "description" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Description of field shown in server and desktop clients
+	GetDescription() string
+	// GetFullyQualifiedName returns the interface-field "fullyQualifiedName" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name internally used to uniquely identify fields
+	GetFullyQualifiedName() string
+	// GetFolderName returns the interface-field "folderName" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	GetFolderName() string
+	// GetIsHidden returns the interface-field "isHidden" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// True if the field is hidden
+	GetIsHidden() bool
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField() {
+}
+
+func __unmarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField(b []byte, v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "BinField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField)
+		return json.Unmarshal(b, *v)
+	case "CalculatedField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField)
+		return json.Unmarshal(b, *v)
+	case "ColumnField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField)
+		return json.Unmarshal(b, *v)
+	case "CombinedField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField)
+		return json.Unmarshal(b, *v)
+	case "CombinedSetField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField)
+		return json.Unmarshal(b, *v)
+	case "DatasourceField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField)
+		return json.Unmarshal(b, *v)
+	case "GroupField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField)
+		return json.Unmarshal(b, *v)
+	case "HierarchyField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField)
+		return json.Unmarshal(b, *v)
+	case "SetField":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Field.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField(v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField:
+		typename = "BinField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsBinField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField:
+		typename = "CalculatedField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCalculatedField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField:
+		typename = "ColumnField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsColumnField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField:
+		typename = "CombinedField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField:
+		typename = "CombinedSetField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsCombinedSetField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField:
+		typename = "DatasourceField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsDatasourceField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField:
+		typename = "GroupField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField:
+		typename = "HierarchyField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField:
+		typename = "SetField"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsField: "%T"`, v)
+	}
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField includes the requested fields of the GraphQL type GroupField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a group field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_groups_creating.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsGroupField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField includes the requested fields of the GraphQL type HierarchyField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a hierarchy. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/qs_hierarchies.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsHierarchyField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField includes the requested fields of the GraphQL type SetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Description of field shown in server and desktop clients
+	Description string `json:"description"`
+	// Name internally used to uniquely identify fields
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	// Name of folder if the field is in a folder. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/datafields_dwfeatures.html#Organize
+	FolderName string `json:"folderName"`
+	// True if the field is hidden
+	IsHidden bool `json:"isHidden"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField) GetDescription() string {
+	return v.Description
+}
+
+// GetFullyQualifiedName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField.FullyQualifiedName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField) GetFullyQualifiedName() string {
+	return v.FullyQualifiedName
+}
+
+// GetFolderName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField.FolderName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField) GetFolderName() string {
+	return v.FolderName
+}
+
+// GetIsHidden returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField.IsHidden, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceFieldsSetField) GetIsHidden() bool {
+	return v.IsHidden
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser includes the requested fields of the GraphQL type TableauUser.
+// The GraphQL type's documentation follows.
+//
+// User on a site on Tableau server
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser struct {
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Display name of this user
+	Name string `json:"name"`
+	// Username of this user
+	Username string `json:"username"`
+	// Email address of this user
+	Email string `json:"email"`
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser.Luid, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser) GetName() string {
+	return v.Name
+}
+
+// GetUsername returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser.Username, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser) GetUsername() string {
+	return v.Username
+}
+
+// GetEmail returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser.Email, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceOwnerTableauUser) GetEmail() string {
+	return v.Email
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// tag associated with content items
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag struct {
+	// Unique identifier used by the metadata API.
+	Id string `json:"id"`
+	// The name of the tag
+	Name string `json:"name"`
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceTagsTag) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource.Luid, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamDatasourcesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+	// The database to which this table belongs
+	Database GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase `json:"-"`
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetSchema returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetConnectionType returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDatabase returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable.Database, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) GetDatabase() GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase {
+	return v.Database
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable
+		Database json.RawMessage `json:"database"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Database
+		src := firstPass.Database
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable.Database: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Schema string `json:"schema"`
+
+	FullName string `json:"fullName"`
+
+	ConnectionType string `json:"connectionType"`
+
+	Database json.RawMessage `json:"database"`
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable) __premarshalJSON() (*__premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable, error) {
+	var retval __premarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Schema = v.Schema
+	retval.FullName = v.FullName
+	retval.ConnectionType = v.ConnectionType
+	{
+
+		dst := &retval.Database
+		src := v.Database
+		var err error
+		*dst, err = __marshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTable.Database: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase is implemented by the following types:
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase interface {
+	implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+}
+
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase() {
+}
+
+func __unmarshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase(b []byte, v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase(v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabase: "%T"`, v)
+	}
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasourceUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetPublishedDatasourcesPublishedDatasourcesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetPublishedDatasourcesPublishedDatasourcesConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetPublishedDatasourcesPublishedDatasourcesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns GetPublishedDatasourcesPublishedDatasourcesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesPublishedDatasourcesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetPublishedDatasourcesResponse is returned by GetPublishedDatasources on success.
+type GetPublishedDatasourcesResponse struct {
+	// Fetch PublishedDatasources with support for pagination
+	PublishedDatasourcesConnection GetPublishedDatasourcesPublishedDatasourcesConnection `json:"publishedDatasourcesConnection"`
+}
+
+// GetPublishedDatasourcesConnection returns GetPublishedDatasourcesResponse.PublishedDatasourcesConnection, and is useful for accessing the field via an interface.
+func (v *GetPublishedDatasourcesResponse) GetPublishedDatasourcesConnection() GetPublishedDatasourcesPublishedDatasourcesConnection {
+	return v.PublishedDatasourcesConnection
+}
+
+// GetSheetFieldLineageResponse is returned by GetSheetFieldLineage on success.
+type GetSheetFieldLineageResponse struct {
+	// Fetch Sheets with support for pagination
+	SheetsConnection GetSheetFieldLineageSheetsConnection `json:"sheetsConnection"`
+}
+
+// GetSheetsConnection returns GetSheetFieldLineageResponse.SheetsConnection, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageResponse) GetSheetsConnection() GetSheetFieldLineageSheetsConnection {
+	return v.SheetsConnection
+}
+
+// GetSheetFieldLineageSheetsConnection includes the requested fields of the GraphQL type SheetsConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Sheet
+type GetSheetFieldLineageSheetsConnection struct {
+	// List of nodes
+	Nodes []GetSheetFieldLineageSheetsConnectionNodesSheet `json:"nodes"`
+	// Information for pagination
+	PageInfo GetSheetFieldLineageSheetsConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetSheetFieldLineageSheetsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnection) GetNodes() []GetSheetFieldLineageSheetsConnectionNodesSheet {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetSheetFieldLineageSheetsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnection) GetPageInfo() GetSheetFieldLineageSheetsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetSheetFieldLineageSheetsConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetSheetFieldLineageSheetsConnectionNodesSheet includes the requested fields of the GraphQL type Sheet.
+// The GraphQL type's documentation follows.
+//
+// sheet contained in a published workbook.
+type GetSheetFieldLineageSheetsConnectionNodesSheet struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// The workbook that contains this view
+	Workbook GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook `json:"workbook"`
+	// All fields in the collection worksheetFields as well as the fields in datasourceFields used directly by this sheet. If the worksheet uses calculated fields this list will not include referenced fields that are not directly used by the sheet.
+	SheetFieldInstances []GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheet.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheet) GetTypename() string { return v.Typename }
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheet.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheet) GetId() string { return v.Id }
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheet.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheet) GetName() string { return v.Name }
+
+// GetWorkbook returns GetSheetFieldLineageSheetsConnectionNodesSheet.Workbook, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheet) GetWorkbook() GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook {
+	return v.Workbook
+}
+
+// GetSheetFieldInstances returns GetSheetFieldLineageSheetsConnectionNodesSheet.SheetFieldInstances, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheet) GetSheetFieldInstances() []GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField {
+	return v.SheetFieldInstances
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheet) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheet
+		SheetFieldInstances []json.RawMessage `json:"sheetFieldInstances"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheet = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.SheetFieldInstances
+		src := firstPass.SheetFieldInstances
+		*dst = make(
+			[]GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheet.SheetFieldInstances: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheet struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Workbook GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook `json:"workbook"`
+
+	SheetFieldInstances []json.RawMessage `json:"sheetFieldInstances"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheet) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheet) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheet, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheet
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Workbook = v.Workbook
+	{
+
+		dst := &retval.SheetFieldInstances
+		src := v.SheetFieldInstances
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheet.SheetFieldInstances: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField includes the requested fields of the GraphQL type BinField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a binned continuous measure field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_bins.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField includes the requested fields of the GraphQL type CalculatedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField includes the requested fields of the GraphQL type CombinedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined field. Combined fields concatanate fields together into one string.
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField includes the requested fields of the GraphQL type CombinedSetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html#Combine
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField includes the requested fields of the GraphQL type DatasourceField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a data source field. Data source fields can only exist in embedded data sources which connect to a published data source. A data source field is an embedded data source's 'layered' representation of a field that already exists in the published data source and is mostly a copy of the field in the published data source. Data source fields can get their own descriptions and renames local to the embedded data source, but cannot otherwise be modified in the embedded data source.
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+	// Reference to a field from a published data source. This property only exists on Fields that are in an embedded data source with a connection to a published data source.
+	RemoteField GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+// GetRemoteField returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.RemoteField, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) GetRemoteField() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField {
+	return v.RemoteField
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField
+		Datasource  json.RawMessage `json:"datasource"`
+		RemoteField json.RawMessage `json:"remoteField"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.Datasource: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.RemoteField
+		src := firstPass.RemoteField
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.RemoteField: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+
+	RemoteField json.RawMessage `json:"remoteField"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.Datasource: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.RemoteField
+		src := v.RemoteField
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField.RemoteField: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField includes the requested fields of the GraphQL interface Field.
+//
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField is implemented by the following types:
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField
+// The GraphQL type's documentation follows.
+//
+// Base GraphQL type for a field
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField interface {
+	implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server
+	GetName() string
+	// GetDatasource returns the interface-field "datasource" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Data source that contains this field
+	GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField() {
+}
+
+func __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField(b []byte, v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "BinField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField)
+		return json.Unmarshal(b, *v)
+	case "CalculatedField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField)
+		return json.Unmarshal(b, *v)
+	case "ColumnField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField)
+		return json.Unmarshal(b, *v)
+	case "CombinedField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField)
+		return json.Unmarshal(b, *v)
+	case "CombinedSetField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField)
+		return json.Unmarshal(b, *v)
+	case "DatasourceField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField)
+		return json.Unmarshal(b, *v)
+	case "GroupField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField)
+		return json.Unmarshal(b, *v)
+	case "HierarchyField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField)
+		return json.Unmarshal(b, *v)
+	case "SetField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Field.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField(v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField:
+		typename = "BinField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField:
+		typename = "CalculatedField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField:
+		typename = "ColumnField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField:
+		typename = "CombinedField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField:
+		typename = "CombinedSetField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField:
+		typename = "DatasourceField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField:
+		typename = "GroupField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField:
+		typename = "HierarchyField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField:
+		typename = "SetField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteField: "%T"`, v)
+	}
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField includes the requested fields of the GraphQL type BinField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a binned continuous measure field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_bins.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldBinField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField includes the requested fields of the GraphQL type CalculatedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a calculated field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/calculations_calculatedfields.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCalculatedField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField includes the requested fields of the GraphQL type ColumnField.
+// The GraphQL type's documentation follows.
+//
+// ColumnFields are a type of field which directly connects to a column in some type of table.
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldColumnField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField includes the requested fields of the GraphQL type CombinedField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined field. Combined fields concatanate fields together into one string.
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField includes the requested fields of the GraphQL type CombinedSetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a combined set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html#Combine
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldCombinedSetField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource includes the requested fields of the GraphQL interface Datasource.
+//
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource is implemented by the following types:
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource
+// The GraphQL type's documentation follows.
+//
+// # Root GraphQL type for embedded and published data sources
+//
+// Data sources are a way to represent how Tableau Desktop and Tableau Server model and connect to data. Data sources can be published separately, as a published data source, or may be contained in a workbook as an embedded data source.
+//
+// See https://onlinehelp.tableau.com/current/server/en-us/datasource.htm
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource interface {
+	implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource() {
+}
+
+func __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(b []byte, v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmbeddedDatasource":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource)
+		return json.Unmarshal(b, *v)
+	case "PublishedDatasource":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Datasource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource:
+		typename = "EmbeddedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource:
+		typename = "PublishedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource: "%T"`, v)
+	}
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceEmbeddedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField includes the requested fields of the GraphQL type DatasourceField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a data source field. Data source fields can only exist in embedded data sources which connect to a published data source. A data source field is an embedded data source's 'layered' representation of a field that already exists in the published data source and is mostly a copy of the field in the published data source. Data source fields can get their own descriptions and renames local to the embedded data source, but cannot otherwise be modified in the embedded data source.
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourceField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasourcePublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField includes the requested fields of the GraphQL type GroupField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a group field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_groups_creating.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldGroupField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField includes the requested fields of the GraphQL type HierarchyField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a hierarchy. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/qs_hierarchies.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldHierarchyField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField includes the requested fields of the GraphQL type SetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceFieldRemoteFieldSetField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField includes the requested fields of the GraphQL interface Field.
+//
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField is implemented by the following types:
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField
+// The GraphQL type's documentation follows.
+//
+// Base GraphQL type for a field
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField interface {
+	implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server
+	GetName() string
+	// GetDatasource returns the interface-field "datasource" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Data source that contains this field
+	GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField() {
+}
+
+func __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField(b []byte, v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "BinField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField)
+		return json.Unmarshal(b, *v)
+	case "CalculatedField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField)
+		return json.Unmarshal(b, *v)
+	case "ColumnField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField)
+		return json.Unmarshal(b, *v)
+	case "CombinedField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField)
+		return json.Unmarshal(b, *v)
+	case "CombinedSetField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField)
+		return json.Unmarshal(b, *v)
+	case "DatasourceField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField)
+		return json.Unmarshal(b, *v)
+	case "GroupField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField)
+		return json.Unmarshal(b, *v)
+	case "HierarchyField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField)
+		return json.Unmarshal(b, *v)
+	case "SetField":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Field.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField(v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField:
+		typename = "BinField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesBinField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField:
+		typename = "CalculatedField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCalculatedField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField:
+		typename = "ColumnField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesColumnField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField:
+		typename = "CombinedField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField:
+		typename = "CombinedSetField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesCombinedSetField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField:
+		typename = "DatasourceField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesDatasourceField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField:
+		typename = "GroupField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField:
+		typename = "HierarchyField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField:
+		typename = "SetField"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesField: "%T"`, v)
+	}
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource includes the requested fields of the GraphQL interface Datasource.
+//
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource is implemented by the following types:
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource
+// The GraphQL type's documentation follows.
+//
+// # Root GraphQL type for embedded and published data sources
+//
+// Data sources are a way to represent how Tableau Desktop and Tableau Server model and connect to data. Data sources can be published separately, as a published data source, or may be contained in a workbook as an embedded data source.
+//
+// See https://onlinehelp.tableau.com/current/server/en-us/datasource.htm
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource interface {
+	implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource() {
+}
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource) implementsGraphQLInterfaceGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource() {
+}
+
+func __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(b []byte, v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmbeddedDatasource":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource)
+		return json.Unmarshal(b, *v)
+	case "PublishedDatasource":
+		*v = new(GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Datasource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource:
+		typename = "EmbeddedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource:
+		typename = "PublishedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource: "%T"`, v)
+	}
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourceEmbeddedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasourcePublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField includes the requested fields of the GraphQL type GroupField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a group field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_groups_creating.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesGroupField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField includes the requested fields of the GraphQL type HierarchyField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a hierarchy. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/qs_hierarchies.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesHierarchyField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField includes the requested fields of the GraphQL type SetField.
+// The GraphQL type's documentation follows.
+//
+// GraphQL type for a set field. See https://onlinehelp.tableau.com/current/pro/desktop/en-us/sortgroup_sets_create.html
+type GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server
+	Name string `json:"name"`
+	// Data source that contains this field
+	Datasource GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField) GetName() string {
+	return v.Name
+}
+
+// GetDatasource returns GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField.Datasource, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField) GetDatasource() GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource {
+	return v.Datasource
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField
+		Datasource json.RawMessage `json:"datasource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Datasource
+		src := firstPass.Datasource
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField.Datasource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Datasource json.RawMessage `json:"datasource"`
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField) __premarshalJSON() (*__premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField, error) {
+	var retval __premarshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Datasource
+		src := v.Datasource
+		var err error
+		*dst, err = __marshalGetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesFieldDatasource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetSheetFieldLineageSheetsConnectionNodesSheetSheetFieldInstancesSetField.Datasource: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook includes the requested fields of the GraphQL type Workbook.
+// The GraphQL type's documentation follows.
+//
+// Workbooks are used to package up Tableau visualizations (which are called "sheets" in the Metadata API) and data models (which are called "embedded data sources" when they are owned by a workbook).
+type GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook) GetId() string { return v.Id }
+
+// GetName returns GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionNodesSheetWorkbook) GetName() string { return v.Name }
+
+// GetSheetFieldLineageSheetsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetSheetFieldLineageSheetsConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetSheetFieldLineageSheetsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns GetSheetFieldLineageSheetsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetSheetFieldLineageSheetsConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetSheetsResponse is returned by GetSheets on success.
+type GetSheetsResponse struct {
+	// Fetch Sheets with support for pagination
+	SheetsConnection GetSheetsSheetsConnection `json:"sheetsConnection"`
+}
+
+// GetSheetsConnection returns GetSheetsResponse.SheetsConnection, and is useful for accessing the field via an interface.
+func (v *GetSheetsResponse) GetSheetsConnection() GetSheetsSheetsConnection {
+	return v.SheetsConnection
+}
+
+// GetSheetsSheetsConnection includes the requested fields of the GraphQL type SheetsConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Sheet
+type GetSheetsSheetsConnection struct {
+	// List of nodes
+	Nodes []GetSheetsSheetsConnectionNodesSheet `json:"nodes"`
+	// Information for pagination
+	PageInfo GetSheetsSheetsConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetSheetsSheetsConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnection) GetNodes() []GetSheetsSheetsConnectionNodesSheet { return v.Nodes }
+
+// GetPageInfo returns GetSheetsSheetsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnection) GetPageInfo() GetSheetsSheetsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetSheetsSheetsConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetSheetsSheetsConnectionNodesSheet includes the requested fields of the GraphQL type Sheet.
+// The GraphQL type's documentation follows.
+//
+// sheet contained in a published workbook.
+type GetSheetsSheetsConnectionNodesSheet struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server (Blank if worksheet is hidden in Workbook)
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Server path to sheet
+	Path string `json:"path"`
+	// Time the sheet was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time the sheet was updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The workbook that contains this view
+	Workbook GetSheetsSheetsConnectionNodesSheetWorkbook `json:"workbook"`
+	// Dashboards that contain this sheet
+	ContainedInDashboards []GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard `json:"containedInDashboards"`
+	// Tags associated with the view
+	Tags []GetSheetsSheetsConnectionNodesSheetTagsTag `json:"tags"`
+	// The tables that are upstream of this sheet
+	UpstreamTables []GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable `json:"-"`
+	// The data sources that are upstream of this sheet
+	UpstreamDatasources []GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource `json:"-"`
+}
+
+// GetTypename returns GetSheetsSheetsConnectionNodesSheet.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetTypename() string { return v.Typename }
+
+// GetId returns GetSheetsSheetsConnectionNodesSheet.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetId() string { return v.Id }
+
+// GetLuid returns GetSheetsSheetsConnectionNodesSheet.Luid, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetLuid() string { return v.Luid }
+
+// GetName returns GetSheetsSheetsConnectionNodesSheet.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetName() string { return v.Name }
+
+// GetPath returns GetSheetsSheetsConnectionNodesSheet.Path, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetPath() string { return v.Path }
+
+// GetCreatedAt returns GetSheetsSheetsConnectionNodesSheet.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetSheetsSheetsConnectionNodesSheet.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetWorkbook returns GetSheetsSheetsConnectionNodesSheet.Workbook, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetWorkbook() GetSheetsSheetsConnectionNodesSheetWorkbook {
+	return v.Workbook
+}
+
+// GetContainedInDashboards returns GetSheetsSheetsConnectionNodesSheet.ContainedInDashboards, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetContainedInDashboards() []GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard {
+	return v.ContainedInDashboards
+}
+
+// GetTags returns GetSheetsSheetsConnectionNodesSheet.Tags, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetTags() []GetSheetsSheetsConnectionNodesSheetTagsTag {
+	return v.Tags
+}
+
+// GetUpstreamTables returns GetSheetsSheetsConnectionNodesSheet.UpstreamTables, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetUpstreamTables() []GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable {
+	return v.UpstreamTables
+}
+
+// GetUpstreamDatasources returns GetSheetsSheetsConnectionNodesSheet.UpstreamDatasources, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheet) GetUpstreamDatasources() []GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource {
+	return v.UpstreamDatasources
+}
+
+func (v *GetSheetsSheetsConnectionNodesSheet) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetSheetsSheetsConnectionNodesSheet
+		UpstreamTables      []json.RawMessage `json:"upstreamTables"`
+		UpstreamDatasources []json.RawMessage `json:"upstreamDatasources"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetSheetsSheetsConnectionNodesSheet = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.UpstreamTables
+		src := firstPass.UpstreamTables
+		*dst = make(
+			[]GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetSheetsSheetsConnectionNodesSheetUpstreamTablesTable(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetSheetsSheetsConnectionNodesSheet.UpstreamTables: %w", err)
+				}
+			}
+		}
+	}
+
+	{
+		dst := &v.UpstreamDatasources
+		src := firstPass.UpstreamDatasources
+		*dst = make(
+			[]GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal GetSheetsSheetsConnectionNodesSheet.UpstreamDatasources: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetSheetsSheetsConnectionNodesSheet struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Luid string `json:"luid"`
+
+	Name string `json:"name"`
+
+	Path string `json:"path"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Workbook GetSheetsSheetsConnectionNodesSheetWorkbook `json:"workbook"`
+
+	ContainedInDashboards []GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard `json:"containedInDashboards"`
+
+	Tags []GetSheetsSheetsConnectionNodesSheetTagsTag `json:"tags"`
+
+	UpstreamTables []json.RawMessage `json:"upstreamTables"`
+
+	UpstreamDatasources []json.RawMessage `json:"upstreamDatasources"`
+}
+
+func (v *GetSheetsSheetsConnectionNodesSheet) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetSheetsSheetsConnectionNodesSheet) __premarshalJSON() (*__premarshalGetSheetsSheetsConnectionNodesSheet, error) {
+	var retval __premarshalGetSheetsSheetsConnectionNodesSheet
+
+	retval.Typename = v.Typename
+	retval.Id = v.Id
+	retval.Luid = v.Luid
+	retval.Name = v.Name
+	retval.Path = v.Path
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.Workbook = v.Workbook
+	retval.ContainedInDashboards = v.ContainedInDashboards
+	retval.Tags = v.Tags
+	{
+
+		dst := &retval.UpstreamTables
+		src := v.UpstreamTables
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetSheetsSheetsConnectionNodesSheetUpstreamTablesTable(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetSheetsSheetsConnectionNodesSheet.UpstreamTables: %w", err)
+			}
+		}
+	}
+	{
+
+		dst := &retval.UpstreamDatasources
+		src := v.UpstreamDatasources
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal GetSheetsSheetsConnectionNodesSheet.UpstreamDatasources: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard includes the requested fields of the GraphQL type Dashboard.
+// The GraphQL type's documentation follows.
+//
+// dashboard contained in a published workbook.
+type GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server (Blank if worksheet is hidden in Workbook)
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard.Luid, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetContainedInDashboardsDashboard) GetName() string {
+	return v.Name
+}
+
+// GetSheetsSheetsConnectionNodesSheetTagsTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// tag associated with content items
+type GetSheetsSheetsConnectionNodesSheetTagsTag struct {
+	// Unique identifier used by the metadata API.
+	Id string `json:"id"`
+	// The name of the tag
+	Name string `json:"name"`
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetTagsTag) GetId() string { return v.Id }
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetTagsTag) GetName() string { return v.Name }
+
+// GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource includes the requested fields of the GraphQL interface Datasource.
+//
+// GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource is implemented by the following types:
+// GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource
+// GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource
+// The GraphQL type's documentation follows.
+//
+// # Root GraphQL type for embedded and published data sources
+//
+// Data sources are a way to represent how Tableau Desktop and Tableau Server model and connect to data. Data sources can be published separately, as a published data source, or may be contained in a workbook as an embedded data source.
+//
+// See https://onlinehelp.tableau.com/current/server/en-us/datasource.htm
+type GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource interface {
+	implementsGraphQLInterfaceGetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource) implementsGraphQLInterfaceGetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource() {
+}
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource) implementsGraphQLInterfaceGetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource() {
+}
+
+func __unmarshalGetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource(b []byte, v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "EmbeddedDatasource":
+		*v = new(GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource)
+		return json.Unmarshal(b, *v)
+	case "PublishedDatasource":
+		*v = new(GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Datasource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource(v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource:
+		typename = "EmbeddedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource:
+		typename = "PublishedDatasource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesDatasource: "%T"`, v)
+	}
+}
+
+// GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesEmbeddedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamDatasourcesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable includes the requested fields of the GraphQL type CustomSQLTable.
+// The GraphQL type's documentation follows.
+//
+// table that represents the result of evaluating a custom SQL query. These "tables" are owned by the Tableau data source (embedded or published) which contains the SQL query, so they only exist within that data source.
+type GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable) GetId() string { return v.Id }
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable) GetName() string {
+	return v.Name
+}
+
+// GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable) GetId() string { return v.Id }
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetSchema returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetConnectionType returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable includes the requested fields of the GraphQL interface Table.
+//
+// GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable is implemented by the following types:
+// GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable
+// GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable
+// GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable
+// The GraphQL type's documentation follows.
+//
+// table containing columns
+type GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable interface {
+	implementsGraphQLInterfaceGetSheetsSheetsConnectionNodesSheetUpstreamTablesTable()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+}
+
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable) implementsGraphQLInterfaceGetSheetsSheetsConnectionNodesSheetUpstreamTablesTable() {
+}
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable) implementsGraphQLInterfaceGetSheetsSheetsConnectionNodesSheetUpstreamTablesTable() {
+}
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable) implementsGraphQLInterfaceGetSheetsSheetsConnectionNodesSheetUpstreamTablesTable() {
+}
+
+func __unmarshalGetSheetsSheetsConnectionNodesSheetUpstreamTablesTable(b []byte, v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CustomSQLTable":
+		*v = new(GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable)
+		return json.Unmarshal(b, *v)
+	case "DatabaseTable":
+		*v = new(GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable)
+		return json.Unmarshal(b, *v)
+	case "VirtualConnectionTable":
+		*v = new(GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Table.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetSheetsSheetsConnectionNodesSheetUpstreamTablesTable(v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable:
+		typename = "CustomSQLTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetsSheetsConnectionNodesSheetUpstreamTablesCustomSQLTable
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable:
+		typename = "DatabaseTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetsSheetsConnectionNodesSheetUpstreamTablesDatabaseTable
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable:
+		typename = "VirtualConnectionTable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetSheetsSheetsConnectionNodesSheetUpstreamTablesTable: "%T"`, v)
+	}
+}
+
+// GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable includes the requested fields of the GraphQL type VirtualConnectionTable.
+// The GraphQL type's documentation follows.
+//
+// A table in a virtual connection.
+// *Available in Tableau Cloud March 2022 / Server 2022.1 and later.*
+type GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetTypename returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable.Typename, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetUpstreamTablesVirtualConnectionTable) GetName() string {
+	return v.Name
+}
+
+// GetSheetsSheetsConnectionNodesSheetWorkbook includes the requested fields of the GraphQL type Workbook.
+// The GraphQL type's documentation follows.
+//
+// Workbooks are used to package up Tableau visualizations (which are called "sheets" in the Metadata API) and data models (which are called "embedded data sources" when they are owned by a workbook).
+type GetSheetsSheetsConnectionNodesSheetWorkbook struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// The name of the project in which the workbook is visible and usable.
+	ProjectName string `json:"projectName"`
+	// Uri of the workbook
+	Uri string `json:"uri"`
+	// User who owns this workbook
+	Owner GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser `json:"owner"`
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetWorkbook.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbook) GetId() string { return v.Id }
+
+// GetLuid returns GetSheetsSheetsConnectionNodesSheetWorkbook.Luid, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbook) GetLuid() string { return v.Luid }
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetWorkbook.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbook) GetName() string { return v.Name }
+
+// GetProjectName returns GetSheetsSheetsConnectionNodesSheetWorkbook.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbook) GetProjectName() string { return v.ProjectName }
+
+// GetUri returns GetSheetsSheetsConnectionNodesSheetWorkbook.Uri, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbook) GetUri() string { return v.Uri }
+
+// GetOwner returns GetSheetsSheetsConnectionNodesSheetWorkbook.Owner, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbook) GetOwner() GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser {
+	return v.Owner
+}
+
+// GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser includes the requested fields of the GraphQL type TableauUser.
+// The GraphQL type's documentation follows.
+//
+// User on a site on Tableau server
+type GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser struct {
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Display name of this user
+	Name string `json:"name"`
+	// Username of this user
+	Username string `json:"username"`
+	// Email address of this user
+	Email string `json:"email"`
+}
+
+// GetId returns GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser.Id, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser) GetId() string { return v.Id }
+
+// GetLuid returns GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser.Luid, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser) GetLuid() string { return v.Luid }
+
+// GetName returns GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser.Name, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser) GetName() string { return v.Name }
+
+// GetUsername returns GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser.Username, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser) GetUsername() string {
+	return v.Username
+}
+
+// GetEmail returns GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser.Email, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionNodesSheetWorkbookOwnerTableauUser) GetEmail() string {
+	return v.Email
+}
+
+// GetSheetsSheetsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetSheetsSheetsConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetSheetsSheetsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns GetSheetsSheetsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetSheetsSheetsConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetWorkbookVersionsResponse is returned by GetWorkbookVersions on success.
+type GetWorkbookVersionsResponse struct {
+	// Fetch Workbooks with support for pagination
+	WorkbooksConnection GetWorkbookVersionsWorkbooksConnection `json:"workbooksConnection"`
+}
+
+// GetWorkbooksConnection returns GetWorkbookVersionsResponse.WorkbooksConnection, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsResponse) GetWorkbooksConnection() GetWorkbookVersionsWorkbooksConnection {
+	return v.WorkbooksConnection
+}
+
+// GetWorkbookVersionsWorkbooksConnection includes the requested fields of the GraphQL type WorkbooksConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Workbook
+type GetWorkbookVersionsWorkbooksConnection struct {
+	// List of nodes
+	Nodes []GetWorkbookVersionsWorkbooksConnectionNodesWorkbook `json:"nodes"`
+	// Information for pagination
+	PageInfo GetWorkbookVersionsWorkbooksConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetWorkbookVersionsWorkbooksConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnection) GetNodes() []GetWorkbookVersionsWorkbooksConnectionNodesWorkbook {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetWorkbookVersionsWorkbooksConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnection) GetPageInfo() GetWorkbookVersionsWorkbooksConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetWorkbookVersionsWorkbooksConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetWorkbookVersionsWorkbooksConnectionNodesWorkbook includes the requested fields of the GraphQL type Workbook.
+// The GraphQL type's documentation follows.
+//
+// Workbooks are used to package up Tableau visualizations (which are called "sheets" in the Metadata API) and data models (which are called "embedded data sources" when they are owned by a workbook).
+type GetWorkbookVersionsWorkbooksConnectionNodesWorkbook struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// The name of the project in which the workbook is visible and usable.
+	ProjectName string `json:"projectName"`
+	// Time the workbook was updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetWorkbookVersionsWorkbooksConnectionNodesWorkbook.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnectionNodesWorkbook) GetId() string { return v.Id }
+
+// GetName returns GetWorkbookVersionsWorkbooksConnectionNodesWorkbook.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnectionNodesWorkbook) GetName() string { return v.Name }
+
+// GetProjectName returns GetWorkbookVersionsWorkbooksConnectionNodesWorkbook.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnectionNodesWorkbook) GetProjectName() string {
+	return v.ProjectName
+}
+
+// GetUpdatedAt returns GetWorkbookVersionsWorkbooksConnectionNodesWorkbook.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnectionNodesWorkbook) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetWorkbookVersionsWorkbooksConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetWorkbookVersionsWorkbooksConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetWorkbookVersionsWorkbooksConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns GetWorkbookVersionsWorkbooksConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetWorkbookVersionsWorkbooksConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetWorkbooksResponse is returned by GetWorkbooks on success.
+type GetWorkbooksResponse struct {
+	// Fetch Workbooks with support for pagination
+	WorkbooksConnection GetWorkbooksWorkbooksConnection `json:"workbooksConnection"`
+}
+
+// GetWorkbooksConnection returns GetWorkbooksResponse.WorkbooksConnection, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksResponse) GetWorkbooksConnection() GetWorkbooksWorkbooksConnection {
+	return v.WorkbooksConnection
+}
+
+// GetWorkbooksWorkbooksConnection includes the requested fields of the GraphQL type WorkbooksConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection Type for Workbook
+type GetWorkbooksWorkbooksConnection struct {
+	// List of nodes
+	Nodes []GetWorkbooksWorkbooksConnectionNodesWorkbook `json:"nodes"`
+	// Information for pagination
+	PageInfo GetWorkbooksWorkbooksConnectionPageInfo `json:"pageInfo"`
+	// Total number of objects in connection
+	TotalCount int `json:"totalCount"`
+}
+
+// GetNodes returns GetWorkbooksWorkbooksConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnection) GetNodes() []GetWorkbooksWorkbooksConnectionNodesWorkbook {
+	return v.Nodes
+}
+
+// GetPageInfo returns GetWorkbooksWorkbooksConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnection) GetPageInfo() GetWorkbooksWorkbooksConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns GetWorkbooksWorkbooksConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbook includes the requested fields of the GraphQL type Workbook.
+// The GraphQL type's documentation follows.
+//
+// Workbooks are used to package up Tableau visualizations (which are called "sheets" in the Metadata API) and data models (which are called "embedded data sources" when they are owned by a workbook).
+type GetWorkbooksWorkbooksConnectionNodesWorkbook struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Description of the workbook
+	Description string `json:"description"`
+	// The name of the project in which the workbook is visible and usable.
+	ProjectName string `json:"projectName"`
+	// The luid of the project in which the workbook is visible and usable. Available in Tableau Cloud June 2022 / Server 2022.3 and later.
+	ProjectLuid string `json:"projectLuid"`
+	// Uri of the workbook
+	Uri string `json:"uri"`
+	// Time the workbook was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time the workbook was updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// User who owns this workbook
+	Owner GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser `json:"owner"`
+	// Tags associated with the workbook
+	Tags []GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag `json:"tags"`
+	// The tables upstream to this Workbook
+	UpstreamTables []GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable `json:"upstreamTables"`
+	// The Published Datasources that are upstream to this Workbook
+	UpstreamDatasources []GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource `json:"upstreamDatasources"`
+	// Worksheets that are contained in this workbook
+	Sheets []GetWorkbooksWorkbooksConnectionNodesWorkbookSheetsSheet `json:"sheets"`
+	// Dashboards that are contained in this workbook
+	Dashboards []GetWorkbooksWorkbooksConnectionNodesWorkbookDashboardsDashboard `json:"dashboards"`
+	// Data sources that are embedded in this workbook
+	EmbeddedDatasources []GetWorkbooksWorkbooksConnectionNodesWorkbookEmbeddedDatasourcesEmbeddedDatasource `json:"embeddedDatasources"`
+}
+
+// GetTypename returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Typename, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetTypename() string { return v.Typename }
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetId() string { return v.Id }
+
+// GetLuid returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Luid, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetLuid() string { return v.Luid }
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetName() string { return v.Name }
+
+// GetDescription returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Description, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetDescription() string { return v.Description }
+
+// GetProjectName returns GetWorkbooksWorkbooksConnectionNodesWorkbook.ProjectName, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetProjectName() string { return v.ProjectName }
+
+// GetProjectLuid returns GetWorkbooksWorkbooksConnectionNodesWorkbook.ProjectLuid, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetProjectLuid() string { return v.ProjectLuid }
+
+// GetUri returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Uri, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetUri() string { return v.Uri }
+
+// GetCreatedAt returns GetWorkbooksWorkbooksConnectionNodesWorkbook.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetWorkbooksWorkbooksConnectionNodesWorkbook.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetOwner returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Owner, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetOwner() GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser {
+	return v.Owner
+}
+
+// GetTags returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Tags, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetTags() []GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag {
+	return v.Tags
+}
+
+// GetUpstreamTables returns GetWorkbooksWorkbooksConnectionNodesWorkbook.UpstreamTables, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetUpstreamTables() []GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable {
+	return v.UpstreamTables
+}
+
+// GetUpstreamDatasources returns GetWorkbooksWorkbooksConnectionNodesWorkbook.UpstreamDatasources, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetUpstreamDatasources() []GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource {
+	return v.UpstreamDatasources
+}
+
+// GetSheets returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Sheets, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetSheets() []GetWorkbooksWorkbooksConnectionNodesWorkbookSheetsSheet {
+	return v.Sheets
+}
+
+// GetDashboards returns GetWorkbooksWorkbooksConnectionNodesWorkbook.Dashboards, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetDashboards() []GetWorkbooksWorkbooksConnectionNodesWorkbookDashboardsDashboard {
+	return v.Dashboards
+}
+
+// GetEmbeddedDatasources returns GetWorkbooksWorkbooksConnectionNodesWorkbook.EmbeddedDatasources, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbook) GetEmbeddedDatasources() []GetWorkbooksWorkbooksConnectionNodesWorkbookEmbeddedDatasourcesEmbeddedDatasource {
+	return v.EmbeddedDatasources
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookDashboardsDashboard includes the requested fields of the GraphQL type Dashboard.
+// The GraphQL type's documentation follows.
+//
+// dashboard contained in a published workbook.
+type GetWorkbooksWorkbooksConnectionNodesWorkbookDashboardsDashboard struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookDashboardsDashboard.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookDashboardsDashboard) GetId() string { return v.Id }
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookEmbeddedDatasourcesEmbeddedDatasource includes the requested fields of the GraphQL type EmbeddedDatasource.
+// The GraphQL type's documentation follows.
+//
+// data source embedded in a workbook
+type GetWorkbooksWorkbooksConnectionNodesWorkbookEmbeddedDatasourcesEmbeddedDatasource struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookEmbeddedDatasourcesEmbeddedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookEmbeddedDatasourcesEmbeddedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser includes the requested fields of the GraphQL type TableauUser.
+// The GraphQL type's documentation follows.
+//
+// User on a site on Tableau server
+type GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser struct {
+	// Unique identifier used by the metadata API. Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Display name of this user
+	Name string `json:"name"`
+	// Username of this user
+	Username string `json:"username"`
+	// Email address of this user
+	Email string `json:"email"`
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser) GetId() string { return v.Id }
+
+// GetLuid returns GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser.Luid, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser) GetName() string {
+	return v.Name
+}
+
+// GetUsername returns GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser.Username, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser) GetUsername() string {
+	return v.Username
+}
+
+// GetEmail returns GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser.Email, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookOwnerTableauUser) GetEmail() string {
+	return v.Email
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookSheetsSheet includes the requested fields of the GraphQL type Sheet.
+// The GraphQL type's documentation follows.
+//
+// sheet contained in a published workbook.
+type GetWorkbooksWorkbooksConnectionNodesWorkbookSheetsSheet struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookSheetsSheet.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookSheetsSheet) GetId() string { return v.Id }
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// tag associated with content items
+type GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag struct {
+	// Unique identifier used by the metadata API.
+	Id string `json:"id"`
+	// The name of the tag
+	Name string `json:"name"`
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag) GetId() string { return v.Id }
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookTagsTag) GetName() string { return v.Name }
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource includes the requested fields of the GraphQL type PublishedDatasource.
+// The GraphQL type's documentation follows.
+//
+// Tableau data source that has been published separately to Tableau Server. It can be used by multiple workbooks.
+type GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Locally unique identifier used for the REST API on the Tableau Server
+	Luid string `json:"luid"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource) GetId() string {
+	return v.Id
+}
+
+// GetLuid returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource.Luid, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource) GetLuid() string {
+	return v.Luid
+}
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamDatasourcesPublishedDatasource) GetName() string {
+	return v.Name
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable includes the requested fields of the GraphQL type DatabaseTable.
+// The GraphQL type's documentation follows.
+//
+// table that is contained in a database
+type GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable struct {
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Name of table schema.
+	//
+	// Note: For some databases, such as Amazon Athena and Exasol, the schema attribute may not return the correct schema name for the table. For more information, see https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html#schema_attribute.
+	Schema string `json:"schema"`
+	// Fully qualified table name
+	FullName string `json:"fullName"`
+	// Connection type of parent database
+	ConnectionType string `json:"connectionType"`
+	// The database to which this table belongs
+	Database GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase `json:"-"`
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) GetName() string {
+	return v.Name
+}
+
+// GetSchema returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable.Schema, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) GetSchema() string {
+	return v.Schema
+}
+
+// GetFullName returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable.FullName, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) GetFullName() string {
+	return v.FullName
+}
+
+// GetConnectionType returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetDatabase returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable.Database, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) GetDatabase() GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase {
+	return v.Database
+}
+
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable
+		Database json.RawMessage `json:"database"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Database
+		src := firstPass.Database
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable.Database: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Schema string `json:"schema"`
+
+	FullName string `json:"fullName"`
+
+	ConnectionType string `json:"connectionType"`
+
+	Database json.RawMessage `json:"database"`
+}
+
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable) __premarshalJSON() (*__premarshalGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable, error) {
+	var retval __premarshalGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Schema = v.Schema
+	retval.FullName = v.FullName
+	retval.ConnectionType = v.ConnectionType
+	{
+
+		dst := &retval.Database
+		src := v.Database
+		var err error
+		*dst, err = __marshalGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTable.Database: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase includes the requested fields of the GraphQL interface Database.
+//
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase is implemented by the following types:
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector
+// The GraphQL type's documentation follows.
+//
+// database containing tables
+type GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase interface {
+	implementsGraphQLInterfaceGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Name shown in server and desktop clients
+	GetName() string
+	// GetConnectionType returns the interface-field "connectionType" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Connection type shortname
+	GetConnectionType() string
+}
+
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile) implementsGraphQLInterfaceGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer) implementsGraphQLInterfaceGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile) implementsGraphQLInterfaceGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase() {
+}
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector) implementsGraphQLInterfaceGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase() {
+}
+
+func __unmarshalGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase(b []byte, v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CloudFile":
+		*v = new(GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile)
+		return json.Unmarshal(b, *v)
+	case "DatabaseServer":
+		*v = new(GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer)
+		return json.Unmarshal(b, *v)
+	case "File":
+		*v = new(GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile)
+		return json.Unmarshal(b, *v)
+	case "WebDataConnector":
+		*v = new(GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Database.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase(v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile:
+		typename = "CloudFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer:
+		typename = "DatabaseServer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile:
+		typename = "File"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector:
+		typename = "WebDataConnector"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabase: "%T"`, v)
+	}
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile includes the requested fields of the GraphQL type CloudFile.
+// The GraphQL type's documentation follows.
+//
+// cloud file connection
+type GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseCloudFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer includes the requested fields of the GraphQL type DatabaseServer.
+// The GraphQL type's documentation follows.
+//
+// database server connection
+type GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer.Typename, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseDatabaseServer) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile includes the requested fields of the GraphQL type File.
+// The GraphQL type's documentation follows.
+//
+// file connection
+type GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile.Typename, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseFile) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector includes the requested fields of the GraphQL type WebDataConnector.
+// The GraphQL type's documentation follows.
+//
+// web data connector
+type GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector struct {
+	Typename string `json:"__typename"`
+	// Unique identifier used by the metadata API.  Not the same as the numeric ID used on server
+	Id string `json:"id"`
+	// Name shown in server and desktop clients
+	Name string `json:"name"`
+	// Connection type shortname
+	ConnectionType string `json:"connectionType"`
+}
+
+// GetTypename returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector.Typename, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector.Id, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector.Name, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetName() string {
+	return v.Name
+}
+
+// GetConnectionType returns GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector.ConnectionType, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionNodesWorkbookUpstreamTablesDatabaseTableDatabaseWebDataConnector) GetConnectionType() string {
+	return v.ConnectionType
+}
+
+// GetWorkbooksWorkbooksConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type GetWorkbooksWorkbooksConnectionPageInfo struct {
+	// Indicates if there are more objects to fetch
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor to use in subsequent query to fetch next page of objects
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetWorkbooksWorkbooksConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns GetWorkbooksWorkbooksConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetWorkbooksWorkbooksConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// Possible types of remote types
+//
+// Types correspond to OLEDB types here: https://referencesource.microsoft.com/#system.data/System/Data/OleDb/OLEDB_Enum.cs,364"
+//
+// Types prefixed with 'WDC' correspond to Tableau's Web Data Connector types:
+// https://tableau.github.io/webdataconnector/docs/api_ref.html#webdataconnectorapi.datatypeenum
+type RemoteType string
+
+const (
+	RemoteTypeArray       RemoteType = "ARRAY"
+	RemoteTypeBool        RemoteType = "BOOL"
+	RemoteTypeBstr        RemoteType = "BSTR"
+	RemoteTypeByref       RemoteType = "BYREF"
+	RemoteTypeBytes       RemoteType = "BYTES"
+	RemoteTypeCy          RemoteType = "CY"
+	RemoteTypeDate        RemoteType = "DATE"
+	RemoteTypeDbdate      RemoteType = "DBDATE"
+	RemoteTypeDbtime      RemoteType = "DBTIME"
+	RemoteTypeDbtimestamp RemoteType = "DBTIMESTAMP"
+	RemoteTypeDecimal     RemoteType = "DECIMAL"
+	RemoteTypeEmpty       RemoteType = "EMPTY"
+	RemoteTypeError       RemoteType = "ERROR"
+	RemoteTypeFiletime    RemoteType = "FILETIME"
+	RemoteTypeGuid        RemoteType = "GUID"
+	RemoteTypeHchapter    RemoteType = "HCHAPTER"
+	RemoteTypeI1          RemoteType = "I1"
+	RemoteTypeI2          RemoteType = "I2"
+	RemoteTypeI4          RemoteType = "I4"
+	RemoteTypeI8          RemoteType = "I8"
+	RemoteTypeIdispatch   RemoteType = "IDISPATCH"
+	RemoteTypeIunknown    RemoteType = "IUNKNOWN"
+	RemoteTypeNull        RemoteType = "NULL"
+	RemoteTypeNumeric     RemoteType = "NUMERIC"
+	RemoteTypePropvariant RemoteType = "PROPVARIANT"
+	RemoteTypeR4          RemoteType = "R4"
+	RemoteTypeR8          RemoteType = "R8"
+	RemoteTypeReserved    RemoteType = "RESERVED"
+	RemoteTypeStr         RemoteType = "STR"
+	RemoteTypeUdt         RemoteType = "UDT"
+	RemoteTypeUi1         RemoteType = "UI1"
+	RemoteTypeUi2         RemoteType = "UI2"
+	RemoteTypeUi4         RemoteType = "UI4"
+	RemoteTypeUi8         RemoteType = "UI8"
+	RemoteTypeVariant     RemoteType = "VARIANT"
+	RemoteTypeVarnumeric  RemoteType = "VARNUMERIC"
+	RemoteTypeVector      RemoteType = "VECTOR"
+	RemoteTypeWdcBool     RemoteType = "WDC_BOOL"
+	RemoteTypeWdcDate     RemoteType = "WDC_DATE"
+	RemoteTypeWdcDatetime RemoteType = "WDC_DATETIME"
+	RemoteTypeWdcFloat    RemoteType = "WDC_FLOAT"
+	RemoteTypeWdcGeometry RemoteType = "WDC_GEOMETRY"
+	RemoteTypeWdcInt      RemoteType = "WDC_INT"
+	RemoteTypeWdcString   RemoteType = "WDC_STRING"
+	RemoteTypeWstr        RemoteType = "WSTR"
+)
+
+// __GetCalculatedFieldLineageInput is used internally by genqlient
+type __GetCalculatedFieldLineageInput struct {
+	First  int                     `json:"first"`
+	After  *string                 `json:"after"`
+	Filter *filter.CalculatedField `json:"filter"`
+}
+
+// GetFirst returns __GetCalculatedFieldLineageInput.First, and is useful for accessing the field via an interface.
+func (v *__GetCalculatedFieldLineageInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetCalculatedFieldLineageInput.After, and is useful for accessing the field via an interface.
+func (v *__GetCalculatedFieldLineageInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetCalculatedFieldLineageInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetCalculatedFieldLineageInput) GetFilter() *filter.CalculatedField { return v.Filter }
+
+// __GetColumnLineageInput is used internally by genqlient
+type __GetColumnLineageInput struct {
+	First  int            `json:"first"`
+	After  *string        `json:"after"`
+	Filter *filter.Column `json:"filter"`
+}
+
+// GetFirst returns __GetColumnLineageInput.First, and is useful for accessing the field via an interface.
+func (v *__GetColumnLineageInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetColumnLineageInput.After, and is useful for accessing the field via an interface.
+func (v *__GetColumnLineageInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetColumnLineageInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetColumnLineageInput) GetFilter() *filter.Column { return v.Filter }
+
+// __GetCustomSQLTablesDefinitionsInput is used internally by genqlient
+type __GetCustomSQLTablesDefinitionsInput struct {
+	First  int                    `json:"first"`
+	After  *string                `json:"after"`
+	Filter *filter.CustomSQLTable `json:"filter"`
+}
+
+// GetFirst returns __GetCustomSQLTablesDefinitionsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetCustomSQLTablesDefinitionsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetCustomSQLTablesDefinitionsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetCustomSQLTablesDefinitionsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetCustomSQLTablesDefinitionsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetCustomSQLTablesDefinitionsInput) GetFilter() *filter.CustomSQLTable { return v.Filter }
+
+// __GetDashboardsInput is used internally by genqlient
+type __GetDashboardsInput struct {
+	First  int               `json:"first"`
+	After  *string           `json:"after"`
+	Filter *filter.Dashboard `json:"filter"`
+}
+
+// GetFirst returns __GetDashboardsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetDashboardsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetDashboardsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetDashboardsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetDashboardsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetDashboardsInput) GetFilter() *filter.Dashboard { return v.Filter }
+
+// __GetDatabaseTableCountsInput is used internally by genqlient
+type __GetDatabaseTableCountsInput struct {
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __GetDatabaseTableCountsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetDatabaseTableCountsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetDatabaseTableCountsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetDatabaseTableCountsInput) GetAfter() *string { return v.After }
+
+// __GetDatabaseTablesDefinitionsInput is used internally by genqlient
+type __GetDatabaseTablesDefinitionsInput struct {
+	First  int                   `json:"first"`
+	After  *string               `json:"after"`
+	Filter *filter.DatabaseTable `json:"filter"`
+}
+
+// GetFirst returns __GetDatabaseTablesDefinitionsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetDatabaseTablesDefinitionsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetDatabaseTablesDefinitionsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetDatabaseTablesDefinitionsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetDatabaseTablesDefinitionsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetDatabaseTablesDefinitionsInput) GetFilter() *filter.DatabaseTable { return v.Filter }
+
+// __GetEmbeddedDatasourcesInput is used internally by genqlient
+type __GetEmbeddedDatasourcesInput struct {
+	First  int                        `json:"first"`
+	After  *string                    `json:"after"`
+	Filter *filter.EmbeddedDatasource `json:"filter"`
+}
+
+// GetFirst returns __GetEmbeddedDatasourcesInput.First, and is useful for accessing the field via an interface.
+func (v *__GetEmbeddedDatasourcesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetEmbeddedDatasourcesInput.After, and is useful for accessing the field via an interface.
+func (v *__GetEmbeddedDatasourcesInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetEmbeddedDatasourcesInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetEmbeddedDatasourcesInput) GetFilter() *filter.EmbeddedDatasource { return v.Filter }
+
+// __GetFlowVersionsInput is used internally by genqlient
+type __GetFlowVersionsInput struct {
+	First  int          `json:"first"`
+	After  *string      `json:"after"`
+	Filter *filter.Flow `json:"filter"`
+}
+
+// GetFirst returns __GetFlowVersionsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetFlowVersionsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetFlowVersionsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetFlowVersionsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetFlowVersionsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetFlowVersionsInput) GetFilter() *filter.Flow { return v.Filter }
+
+// __GetFlowsInput is used internally by genqlient
+type __GetFlowsInput struct {
+	First  int          `json:"first"`
+	After  *string      `json:"after"`
+	Filter *filter.Flow `json:"filter"`
+}
+
+// GetFirst returns __GetFlowsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetFlowsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetFlowsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetFlowsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetFlowsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetFlowsInput) GetFilter() *filter.Flow { return v.Filter }
+
+// __GetPublishedDatasourceVersionsInput is used internally by genqlient
+type __GetPublishedDatasourceVersionsInput struct {
+	First  int                         `json:"first"`
+	After  *string                     `json:"after"`
+	Filter *filter.PublishedDatasource `json:"filter"`
+}
+
+// GetFirst returns __GetPublishedDatasourceVersionsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetPublishedDatasourceVersionsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetPublishedDatasourceVersionsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetPublishedDatasourceVersionsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetPublishedDatasourceVersionsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetPublishedDatasourceVersionsInput) GetFilter() *filter.PublishedDatasource {
+	return v.Filter
+}
+
+// __GetPublishedDatasourcesInput is used internally by genqlient
+type __GetPublishedDatasourcesInput struct {
+	First  int                         `json:"first"`
+	After  *string                     `json:"after"`
+	Filter *filter.PublishedDatasource `json:"filter"`
+}
+
+// GetFirst returns __GetPublishedDatasourcesInput.First, and is useful for accessing the field via an interface.
+func (v *__GetPublishedDatasourcesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetPublishedDatasourcesInput.After, and is useful for accessing the field via an interface.
+func (v *__GetPublishedDatasourcesInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetPublishedDatasourcesInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetPublishedDatasourcesInput) GetFilter() *filter.PublishedDatasource { return v.Filter }
+
+// __GetSheetFieldLineageInput is used internally by genqlient
+type __GetSheetFieldLineageInput struct {
+	First  int           `json:"first"`
+	After  *string       `json:"after"`
+	Filter *filter.Sheet `json:"filter"`
+}
+
+// GetFirst returns __GetSheetFieldLineageInput.First, and is useful for accessing the field via an interface.
+func (v *__GetSheetFieldLineageInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetSheetFieldLineageInput.After, and is useful for accessing the field via an interface.
+func (v *__GetSheetFieldLineageInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetSheetFieldLineageInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetSheetFieldLineageInput) GetFilter() *filter.Sheet { return v.Filter }
+
+// __GetSheetsInput is used internally by genqlient
+type __GetSheetsInput struct {
+	First  int           `json:"first"`
+	After  *string       `json:"after"`
+	Filter *filter.Sheet `json:"filter"`
+}
+
+// GetFirst returns __GetSheetsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetSheetsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetSheetsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetSheetsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetSheetsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetSheetsInput) GetFilter() *filter.Sheet { return v.Filter }
+
+// __GetWorkbookVersionsInput is used internally by genqlient
+type __GetWorkbookVersionsInput struct {
+	First  int              `json:"first"`
+	After  *string          `json:"after"`
+	Filter *filter.Workbook `json:"filter"`
+}
+
+// GetFirst returns __GetWorkbookVersionsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetWorkbookVersionsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetWorkbookVersionsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetWorkbookVersionsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetWorkbookVersionsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetWorkbookVersionsInput) GetFilter() *filter.Workbook { return v.Filter }
+
+// __GetWorkbooksInput is used internally by genqlient
+type __GetWorkbooksInput struct {
+	First  int              `json:"first"`
+	After  *string          `json:"after"`
+	Filter *filter.Workbook `json:"filter"`
+}
+
+// GetFirst returns __GetWorkbooksInput.First, and is useful for accessing the field via an interface.
+func (v *__GetWorkbooksInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetWorkbooksInput.After, and is useful for accessing the field via an interface.
+func (v *__GetWorkbooksInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetWorkbooksInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetWorkbooksInput) GetFilter() *filter.Workbook { return v.Filter }
+
+func GetCalculatedFieldLineage(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.CalculatedField,
+) (*GetCalculatedFieldLineageResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetCalculatedFieldLineage",
+		Query: `
+query GetCalculatedFieldLineage ($first: Int!, $after: String, $filter: CalculatedField_Filter) {
+	calculatedFieldsConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			__typename
+			id
+			name
+			formula
+			datasource {
+				__typename
+				id
+				name
+			}
+			upstreamFields {
+				__typename
+				id
+				name
+				datasource {
+					__typename
+					id
+					name
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetCalculatedFieldLineageInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetCalculatedFieldLineageResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetColumnLineage(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.Column,
+) (*GetColumnLineageResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetColumnLineage",
+		Query: `
+query GetColumnLineage ($first: Int!, $after: String, $filter: Column_Filter) {
+	columnsConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			id
+			name
+			table {
+				__typename
+				id
+				name
+			}
+			referencedByFields {
+				__typename
+				id
+				name
+				datasource {
+					__typename
+					id
+					name
+				}
+			}
+			downstreamFields {
+				__typename
+				id
+				name
+				datasource {
+					__typename
+					id
+					name
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetColumnLineageInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetColumnLineageResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetCustomSQLTablesDefinitions(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.CustomSQLTable,
+) (*GetCustomSQLTablesDefinitionsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetCustomSQLTablesDefinitions",
+		Query: `
+query GetCustomSQLTablesDefinitions ($first: Int!, $after: String, $filter: CustomSQLTable_Filter) {
+	customSQLTablesConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			__typename
+			id
+			name
+			isEmbedded
+			database {
+				__typename
+				id
+				connectionType
+				name
+				description
+			}
+			connectionType
+			description
+			query
+			isUnsupportedCustomSql
+			columns {
+				id
+				name
+				remoteType
+			}
+			tables {
+				id
+				name
+				schema
+				fullName
+				connectionType
+				database {
+					__typename
+					id
+					name
+					connectionType
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetCustomSQLTablesDefinitionsInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetCustomSQLTablesDefinitionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetDashboards(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.Dashboard,
+) (*GetDashboardsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetDashboards",
+		Query: `
+query GetDashboards ($first: Int!, $after: String, $filter: Dashboard_Filter) {
+	dashboardsConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			__typename
+			id
+			luid
+			name
+			path
+			createdAt
+			updatedAt
+			workbook {
+				id
+				luid
+				name
+				projectName
+				uri
+				owner {
+					id
+					luid
+					name
+					username
+					email
+				}
+			}
+			sheets {
+				id
+				luid
+				name
+			}
+			tags {
+				id
+				name
+			}
+			upstreamTables {
+				__typename
+				id
+				name
+				... on DatabaseTable {
+					schema
+					fullName
+					connectionType
+				}
+			}
+			upstreamDatasources {
+				__typename
+				id
+				name
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetDashboardsInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetDashboardsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetDatabaseTableCounts(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+) (*GetDatabaseTableCountsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetDatabaseTableCounts",
+		Query: `
+query GetDatabaseTableCounts ($first: Int!, $after: String) {
+	databasesConnection(first: $first, after: $after) {
+		nodes {
+			__typename
+			id
+			connectionType
+			tablesConnection {
+				totalCount
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetDatabaseTableCountsInput{
+			First: first,
+			After: after,
+		},
+	}
+	var err error
+
+	var data GetDatabaseTableCountsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetDatabaseTablesDefinitions(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.DatabaseTable,
+) (*GetDatabaseTablesDefinitionsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetDatabaseTablesDefinitions",
+		Query: `
+query GetDatabaseTablesDefinitions ($first: Int!, $after: String, $filter: DatabaseTable_Filter) {
+	databaseTablesConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			__typename
+			id
+			name
+			isEmbedded
+			database {
+				__typename
+				id
+				name
+				connectionType
+				description
+			}
+			schema
+			fullName
+			projectName
+			connectionType
+			description
+			isCertified
+			hasActiveWarning
+			columns {
+				id
+				name
+				remoteType
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetDatabaseTablesDefinitionsInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetDatabaseTablesDefinitionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetEmbeddedDatasources(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.EmbeddedDatasource,
+) (*GetEmbeddedDatasourcesResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetEmbeddedDatasources",
+		Query: `
+query GetEmbeddedDatasources ($first: Int!, $after: String, $filter: EmbeddedDatasource_Filter) {
+	embeddedDatasourcesConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			__typename
+			id
+			name
+			createdAt
+			updatedAt
+			hasExtracts
+			containsUnsupportedCustomSql
+			workbook {
+				id
+				luid
+				name
+				projectName
+				owner {
+					id
+					luid
+					name
+					username
+					email
+				}
+			}
+			parentPublishedDatasources {
+				id
+				luid
+				name
+			}
+			upstreamTables {
+				id
+				name
+				schema
+				fullName
+				connectionType
+				database {
+					__typename
+					id
+					name
+					connectionType
+				}
+			}
+			upstreamDatasources {
+				id
+				luid
+				name
+			}
+			fields {
+				__typename
+				id
+				name
+				description
+				fullyQualifiedName
+				folderName
+				isHidden
+				... on ColumnField {
+					dataType
+					role
+				}
+				... on CalculatedField {
+					dataType
+					role
+					formula
+				}
+				... on DatasourceField {
+					remoteField {
+						__typename
+						id
+						name
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetEmbeddedDatasourcesInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetEmbeddedDatasourcesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetFlowVersions(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.Flow,
+) (*GetFlowVersionsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetFlowVersions",
+		Query: `
+query GetFlowVersions ($first: Int!, $after: String, $filter: Flow_Filter) {
+	flowsConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			id
+			name
+			projectName
+			updatedAt
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetFlowVersionsInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetFlowVersionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetFlows(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.Flow,
+) (*GetFlowsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetFlows",
+		Query: `
+query GetFlows ($first: Int!, $after: String, $filter: Flow_Filter) {
+	flowsConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			__typename
+			id
+			luid
+			name
+			description
+			projectName
+			uri
+			createdAt
+			updatedAt
+			owner {
+				id
+				luid
+				name
+				username
+				email
+			}
+			tags {
+				id
+				name
+			}
+			upstreamTables {
+				id
+				name
+				schema
+				fullName
+				connectionType
+				database {
+					__typename
+					id
+					name
+					connectionType
+				}
+			}
+			downstreamTables {
+				id
+				name
+				schema
+				fullName
+				connectionType
+				database {
+					__typename
+					id
+					name
+					connectionType
+				}
+			}
+			upstreamDatasources {
+				id
+				luid
+				name
+			}
+			downstreamDatasources {
+				id
+				luid
+				name
+			}
+			outputSteps {
+				id
+				name
+				outputFields {
+					__typename
+					id
+					name
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetFlowsInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetFlowsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetPublishedDatasourceVersions(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.PublishedDatasource,
+) (*GetPublishedDatasourceVersionsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetPublishedDatasourceVersions",
+		Query: `
+query GetPublishedDatasourceVersions ($first: Int!, $after: String, $filter: PublishedDatasource_Filter) {
+	publishedDatasourcesConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			id
+			name
+			projectName
+			updatedAt
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetPublishedDatasourceVersionsInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetPublishedDatasourceVersionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetEndCursor returns GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsDatabaseTablesConnectionPageInfo) GetEndCursor() string {
-	return v.EndCursor
+	return &data, err
 }
 
-// GetDatabaseTablesDefinitionsResponse is returned by GetDatabaseTablesDefinitions on success.
-type GetDatabaseTablesDefinitionsResponse struct {
-	// Fetch DatabaseTables with support for pagination
-	DatabaseTablesConnection GetDatabaseTablesDefinitionsDatabaseTablesConnection `json:"databaseTablesConnection"`
+func GetPublishedDatasources(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.PublishedDatasource,
+) (*GetPublishedDatasourcesResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetPublishedDatasources",
+		Query: `
+query GetPublishedDatasources ($first: Int!, $after: String, $filter: PublishedDatasource_Filter) {
+	publishedDatasourcesConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			__typename
+			id
+			luid
+			name
+			description
+			projectName
+			uri
+			createdAt
+			updatedAt
+			hasExtracts
+			containsUnsupportedCustomSql
+			isCertified
+			dataQualityCertifications {
+				id
+				isActive
+				message
+				authorDisplayName
+			}
+			owner {
+				id
+				luid
+				name
+				username
+				email
+			}
+			tags {
+				id
+				name
+			}
+			upstreamTables {
+				id
+				name
+				schema
+				fullName
+				connectionType
+				database {
+					__typename
+					id
+					name
+					connectionType
+				}
+			}
+			upstreamDatasources {
+				id
+				luid
+				name
+			}
+			fields {
+				__typename
+				id
+				name
+				description
+				fullyQualifiedName
+				folderName
+				isHidden
+				... on ColumnField {
+					dataType
+					role
+				}
+				... on CalculatedField {
+					dataType
+					role
+					formula
+				}
+				... on DatasourceField {
+					remoteField {
+						__typename
+						id
+						name
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
 }
+`,
+		Variables: &__GetPublishedDatasourcesInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
 
-// GetDatabaseTablesConnection returns GetDatabaseTablesDefinitionsResponse.DatabaseTablesConnection, and is useful for accessing the field via an interface.
-func (v *GetDatabaseTablesDefinitionsResponse) GetDatabaseTablesConnection() GetDatabaseTablesDefinitionsDatabaseTablesConnection {
-	return v.DatabaseTablesConnection
-}
+	var data GetPublishedDatasourcesResponse
+	resp := &graphql.Response{Data: &data}
 
-// Possible types of remote types
-//
-// Types correspond to OLEDB types here: https://referencesource.microsoft.com/#system.data/System/Data/OleDb/OLEDB_Enum.cs,364"
-//
-// Types prefixed with 'WDC' correspond to Tableau's Web Data Connector types:
-// https://tableau.github.io/webdataconnector/docs/api_ref.html#webdataconnectorapi.datatypeenum
-type RemoteType string
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-const (
-	RemoteTypeArray       RemoteType = "ARRAY"
-	RemoteTypeBool        RemoteType = "BOOL"
-	RemoteTypeBstr        RemoteType = "BSTR"
-	RemoteTypeByref       RemoteType = "BYREF"
-	RemoteTypeBytes       RemoteType = "BYTES"
-	RemoteTypeCy          RemoteType = "CY"
-	RemoteTypeDate        RemoteType = "DATE"
-	RemoteTypeDbdate      RemoteType = "DBDATE"
-	RemoteTypeDbtime      RemoteType = "DBTIME"
-	RemoteTypeDbtimestamp RemoteType = "DBTIMESTAMP"
-	RemoteTypeDecimal     RemoteType = "DECIMAL"
-	RemoteTypeEmpty       RemoteType = "EMPTY"
-	RemoteTypeError       RemoteType = "ERROR"
-	RemoteTypeFiletime    RemoteType = "FILETIME"
-	RemoteTypeGuid        RemoteType = "GUID"
-	RemoteTypeHchapter    RemoteType = "HCHAPTER"
-	RemoteTypeI1          RemoteType = "I1"
-	RemoteTypeI2          RemoteType = "I2"
-	RemoteTypeI4          RemoteType = "I4"
-	RemoteTypeI8          RemoteType = "I8"
-	RemoteTypeIdispatch   RemoteType = "IDISPATCH"
-	RemoteTypeIunknown    RemoteType = "IUNKNOWN"
-	RemoteTypeNull        RemoteType = "NULL"
-	RemoteTypeNumeric     RemoteType = "NUMERIC"
-	RemoteTypePropvariant RemoteType = "PROPVARIANT"
-	RemoteTypeR4          RemoteType = "R4"
-	RemoteTypeR8          RemoteType = "R8"
-	RemoteTypeReserved    RemoteType = "RESERVED"
-	RemoteTypeStr         RemoteType = "STR"
-	RemoteTypeUdt         RemoteType = "UDT"
-	RemoteTypeUi1         RemoteType = "UI1"
-	RemoteTypeUi2         RemoteType = "UI2"
-	RemoteTypeUi4         RemoteType = "UI4"
-	RemoteTypeUi8         RemoteType = "UI8"
-	RemoteTypeVariant     RemoteType = "VARIANT"
-	RemoteTypeVarnumeric  RemoteType = "VARNUMERIC"
-	RemoteTypeVector      RemoteType = "VECTOR"
-	RemoteTypeWdcBool     RemoteType = "WDC_BOOL"
-	RemoteTypeWdcDate     RemoteType = "WDC_DATE"
-	RemoteTypeWdcDatetime RemoteType = "WDC_DATETIME"
-	RemoteTypeWdcFloat    RemoteType = "WDC_FLOAT"
-	RemoteTypeWdcGeometry RemoteType = "WDC_GEOMETRY"
-	RemoteTypeWdcInt      RemoteType = "WDC_INT"
-	RemoteTypeWdcString   RemoteType = "WDC_STRING"
-	RemoteTypeWstr        RemoteType = "WSTR"
-)
+	return &data, err
+}
 
-// __GetCustomSQLTablesDefinitionsInput is used internally by genqlient
-type __GetCustomSQLTablesDefinitionsInput struct {
-	First  int `json:"first"`
-	Offset int `json:"offset"`
+func GetSheetFieldLineage(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.Sheet,
+) (*GetSheetFieldLineageResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetSheetFieldLineage",
+		Query: `
+query GetSheetFieldLineage ($first: Int!, $after: String, $filter: Sheet_Filter) {
+	sheetsConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			__typename
+			id
+			name
+			workbook {
+				id
+				name
+			}
+			sheetFieldInstances {
+				__typename
+				id
+				name
+				datasource {
+					__typename
+					id
+					name
+				}
+				... on DatasourceField {
+					remoteField {
+						__typename
+						id
+						name
+						datasource {
+							__typename
+							id
+							name
+						}
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
 }
+`,
+		Variables: &__GetSheetFieldLineageInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
 
-// GetFirst returns __GetCustomSQLTablesDefinitionsInput.First, and is useful for accessing the field via an interface.
-func (v *__GetCustomSQLTablesDefinitionsInput) GetFirst() int { return v.First }
+	var data GetSheetFieldLineageResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetOffset returns __GetCustomSQLTablesDefinitionsInput.Offset, and is useful for accessing the field via an interface.
-func (v *__GetCustomSQLTablesDefinitionsInput) GetOffset() int { return v.Offset }
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// __GetDatabaseTablesDefinitionsInput is used internally by genqlient
-type __GetDatabaseTablesDefinitionsInput struct {
-	First  int `json:"first"`
-	Offset int `json:"offset"`
+	return &data, err
 }
 
-// GetFirst returns __GetDatabaseTablesDefinitionsInput.First, and is useful for accessing the field via an interface.
-func (v *__GetDatabaseTablesDefinitionsInput) GetFirst() int { return v.First }
-
-// GetOffset returns __GetDatabaseTablesDefinitionsInput.Offset, and is useful for accessing the field via an interface.
-func (v *__GetDatabaseTablesDefinitionsInput) GetOffset() int { return v.Offset }
-
-func GetCustomSQLTablesDefinitions(
+func GetSheets(
 	ctx context.Context,
 	client graphql.Client,
 	first int,
-	offset int,
-) (*GetCustomSQLTablesDefinitionsResponse, error) {
+	after *string,
+	filter *filter.Sheet,
+) (*GetSheetsResponse, error) {
 	req := &graphql.Request{
-		OpName: "GetCustomSQLTablesDefinitions",
+		OpName: "GetSheets",
 		Query: `
-query GetCustomSQLTablesDefinitions ($first: Int!, $offset: Int!) {
-	customSQLTablesConnection(first: $first, offset: $offset) {
+query GetSheets ($first: Int!, $after: String, $filter: Sheet_Filter) {
+	sheetsConnection(first: $first, after: $after, filter: $filter) {
 		nodes {
 			__typename
 			id
+			luid
 			name
-			isEmbedded
-			database {
+			path
+			createdAt
+			updatedAt
+			workbook {
+				id
+				luid
+				name
+				projectName
+				uri
+				owner {
+					id
+					luid
+					name
+					username
+					email
+				}
+			}
+			containedInDashboards {
+				id
+				luid
+				name
+			}
+			tags {
+				id
+				name
+			}
+			upstreamTables {
+				__typename
+				id
+				name
+				... on DatabaseTable {
+					schema
+					fullName
+					connectionType
+				}
+			}
+			upstreamDatasources {
 				__typename
 				id
-				connectionType
 				name
-				description
 			}
-			connectionType
-			description
 		}
 		pageInfo {
 			hasNextPage
@@ -1152,14 +17517,15 @@ query GetCustomSQLTablesDefinitions ($first: Int!, $offset: Int!) {
 	}
 }
 `,
-		Variables: &__GetCustomSQLTablesDefinitionsInput{
+		Variables: &__GetSheetsInput{
 			First:  first,
-			Offset: offset,
+			After:  after,
+			Filter: filter,
 		},
 	}
 	var err error
 
-	var data GetCustomSQLTablesDefinitionsResponse
+	var data GetSheetsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1171,37 +17537,112 @@ query GetCustomSQLTablesDefinitions ($first: Int!, $offset: Int!) {
 	return &data, err
 }
 
-func GetDatabaseTablesDefinitions(
+func GetWorkbookVersions(
 	ctx context.Context,
 	client graphql.Client,
 	first int,
-	offset int,
-) (*GetDatabaseTablesDefinitionsResponse, error) {
+	after *string,
+	filter *filter.Workbook,
+) (*GetWorkbookVersionsResponse, error) {
 	req := &graphql.Request{
-		OpName: "GetDatabaseTablesDefinitions",
+		OpName: "GetWorkbookVersions",
+		Query: `
+query GetWorkbookVersions ($first: Int!, $after: String, $filter: Workbook_Filter) {
+	workbooksConnection(first: $first, after: $after, filter: $filter) {
+		nodes {
+			id
+			name
+			projectName
+			updatedAt
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		totalCount
+	}
+}
+`,
+		Variables: &__GetWorkbookVersionsInput{
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data GetWorkbookVersionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetWorkbooks(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	filter *filter.Workbook,
+) (*GetWorkbooksResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetWorkbooks",
 		Query: `
-query GetDatabaseTablesDefinitions ($first: Int!, $offset: Int!) {
-	databaseTablesConnection(first: $first, offset: $offset) {
+query GetWorkbooks ($first: Int!, $after: String, $filter: Workbook_Filter) {
+	workbooksConnection(first: $first, after: $after, filter: $filter) {
 		nodes {
 			__typename
 			id
+			luid
 			name
-			isEmbedded
-			database {
-				__typename
+			description
+			projectName
+			projectLuid
+			uri
+			createdAt
+			updatedAt
+			owner {
+				id
+				luid
+				name
+				username
+				email
+			}
+			tags {
+				id
+				name
+			}
+			upstreamTables {
 				id
 				name
+				schema
+				fullName
 				connectionType
-				description
+				database {
+					__typename
+					id
+					name
+					connectionType
+				}
 			}
-			schema
-			fullName
-			connectionType
-			description
-			columns {
+			upstreamDatasources {
 				id
+				luid
 				name
-				remoteType
+			}
+			sheets {
+				id
+			}
+			dashboards {
+				id
+			}
+			embeddedDatasources {
+				id
 			}
 		}
 		pageInfo {
@@ -1212,14 +17653,15 @@ query GetDatabaseTablesDefinitions ($first: Int!, $offset: Int!) {
 	}
 }
 `,
-		Variables: &__GetDatabaseTablesDefinitionsInput{
+		Variables: &__GetWorkbooksInput{
 			First:  first,
-			Offset: offset,
+			After:  after,
+			Filter: filter,
 		},
 	}
 	var err error
 
-	var data GetDatabaseTablesDefinitionsResponse
+	var data GetWorkbooksResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1 +1,93 @@
 package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/getsynq/connections-tableau/lineage"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/sqlparse"
+	"github.com/getsynq/connections-tableau/warehouse"
+)
+
+type Response struct {
+	// ExtractedAt is when the crawl started, incremental crawls refetch what was updated after it.
+	ExtractedAt          time.Time                                                                                `json:"extractedAt"`
+	DatabaseTables       []*DatabaseTable                                                                         `json:"databaseTables"`
+	CustomSQLTables      []*metadata.GetCustomSQLTablesDefinitionsCustomSQLTablesConnectionNodesCustomSQLTable    `json:"customSQLTables"`
+	CustomSQLReferences  []*CustomSQLReferences                                                                   `json:"customSQLReferences"`
+	PublishedDatasources []metadata.GetPublishedDatasourcesPublishedDatasourcesConnectionNodesPublishedDatasource `json:"publishedDatasources"`
+	EmbeddedDatasources  []metadata.GetEmbeddedDatasourcesEmbeddedDatasourcesConnectionNodesEmbeddedDatasource    `json:"embeddedDatasources"`
+	Workbooks            []metadata.GetWorkbooksWorkbooksConnectionNodesWorkbook                                  `json:"workbooks"`
+	Sheets               []metadata.GetSheetsSheetsConnectionNodesSheet                                           `json:"sheets"`
+	Dashboards           []metadata.GetDashboardsDashboardsConnectionNodesDashboard                               `json:"dashboards"`
+	Flows                []metadata.GetFlowsFlowsConnectionNodesFlow                                              `json:"flows"`
+	ColumnLineage        []lineage.Edge                                                                           `json:"columnLineage"`
+}
+
+// CustomSQLReferences are the warehouse tables and columns parsed from the query of a custom SQL table.
+type CustomSQLReferences struct {
+	CustomSQLTableId string `json:"customSQLTableId"`
+	*sqlparse.Result
+}
+
+// DatabaseTable is a database table with its canonical identifier, which is written as its identifier
+// field. The identifier is derived from the table, so it is normalised again when reading an export,
+// also one written before identifiers were added.
+type DatabaseTable struct {
+	*metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable
+	Identifier TableIdentifier
+}
+
+// NewDatabaseTable normalises the fullName of table into its canonical identifier.
+func NewDatabaseTable(table *metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) *DatabaseTable {
+	var database string
+	if table.Database != nil {
+		database = table.Database.GetName()
+	}
+	id := warehouse.Normalize(table.ConnectionType, database, table.FullName)
+	return &DatabaseTable{
+		GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable: table,
+		Identifier: TableIdentifier{Canonical: id.String(), Identifier: id},
+	}
+}
+
+// DatabaseTables wraps tables with their identifiers.
+func DatabaseTables(tables ...*metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable) []*DatabaseTable {
+	databaseTables := make([]*DatabaseTable, 0, len(tables))
+	for _, table := range tables {
+		databaseTables = append(databaseTables, NewDatabaseTable(table))
+	}
+	return databaseTables
+}
+
+func (t *DatabaseTable) UnmarshalJSON(b []byte) error {
+	table := new(metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable)
+	if err := json.Unmarshal(b, table); err != nil {
+		return err
+	}
+	*t = *NewDatabaseTable(table)
+	return nil
+}
+
+func (t DatabaseTable) MarshalJSON() ([]byte, error) {
+	table, err := json.Marshal(t.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable)
+	if err != nil {
+		return nil, err
+	}
+	identifier, err := json.Marshal(t.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	// the table is an object with at least its __typename, the identifier is added as its last field
+	b := append(table[:len(table)-1], `,"identifier":`...)
+	b = append(b, identifier...)
+	return append(b, '}'), nil
+}
+
+// TableIdentifier is the canonical database.schema.table identifier of a database table, normalised
+// for its connection type so it matches the name in the warehouse's own catalog.
+type TableIdentifier struct {
+	Canonical string `json:"canonical"`
+	warehouse.Identifier
+}
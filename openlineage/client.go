@@ -0,0 +1,65 @@
+package openlineage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Write writes one event per line, the format of the OpenLineage file transport.
+func Write(w io.Writer, events []RunEvent) error {
+	encoder := json.NewEncoder(w)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Client posts events to the HTTP API of an OpenLineage backend, e.g. http://localhost:5000/api/v1/lineage for Marquez.
+type Client struct {
+	Endpoint string
+	// ApiKey is sent as bearer token unless empty.
+	ApiKey     string
+	HttpClient *http.Client
+}
+
+// Emit posts the events one by one and stops at the first one which is rejected.
+func (c *Client) Emit(ctx context.Context, events []RunEvent) error {
+	for _, event := range events {
+		if err := c.emit(ctx, event); err != nil {
+			return fmt.Errorf("failed to emit event of job %s: %w", event.Job.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) emit(ctx context.Context, event RunEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.ApiKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.ApiKey))
+	}
+
+	resp, err := c.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("server responded with status code: %d - %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,416 @@
+// Package openlineage converts an export into OpenLineage run events. Warehouse tables are datasets
+// namespaced by connection type and database, workbooks and datasources are jobs which read their
+// upstream tables and datasources and write a dataset of the same name in the Tableau namespace.
+// Prep flows are jobs which write their downstream tables and datasources.
+package openlineage
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getsynq/connections-tableau/lineage"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/getsynq/connections-tableau/warehouse"
+)
+
+const (
+	Producer  = "https://github.com/getsynq/connections-tableau"
+	SchemaURL = "https://openlineage.io/spec/2-0-2/OpenLineage.json#/$defs/RunEvent"
+
+	schemaFacetURL        = "https://openlineage.io/spec/facets/1-1-1/SchemaDatasetFacet.json#/$defs/SchemaDatasetFacet"
+	columnLineageFacetURL = "https://openlineage.io/spec/facets/1-0-2/ColumnLineageDatasetFacet.json#/$defs/ColumnLineageDatasetFacet"
+	jobTypeFacetURL       = "https://openlineage.io/spec/facets/2-0-2/JobTypeJobFacet.json#/$defs/JobTypeJobFacet"
+)
+
+type RunEvent struct {
+	EventType string    `json:"eventType"`
+	EventTime time.Time `json:"eventTime"`
+	Run       Run       `json:"run"`
+	Job       Job       `json:"job"`
+	Inputs    []Dataset `json:"inputs"`
+	Outputs   []Dataset `json:"outputs"`
+	Producer  string    `json:"producer"`
+	SchemaURL string    `json:"schemaURL"`
+}
+
+type Run struct {
+	RunId string `json:"runId"`
+}
+
+type Job struct {
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Facets    JobFacets `json:"facets,omitempty"`
+}
+
+type JobFacets struct {
+	JobType *JobTypeFacet `json:"jobType,omitempty"`
+}
+
+type Dataset struct {
+	Namespace string        `json:"namespace"`
+	Name      string        `json:"name"`
+	Facets    DatasetFacets `json:"facets"`
+}
+
+type DatasetFacets struct {
+	Schema        *SchemaFacet        `json:"schema,omitempty"`
+	ColumnLineage *ColumnLineageFacet `json:"columnLineage,omitempty"`
+}
+
+// Facet holds the fields every facet starts with.
+type Facet struct {
+	Producer  string `json:"_producer"`
+	SchemaURL string `json:"_schemaURL"`
+}
+
+type JobTypeFacet struct {
+	Facet
+	ProcessingType string `json:"processingType"`
+	Integration    string `json:"integration"`
+	JobType        string `json:"jobType"`
+}
+
+type SchemaFacet struct {
+	Facet
+	Fields []SchemaField `json:"fields"`
+}
+
+type SchemaField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type ColumnLineageFacet struct {
+	Facet
+	Fields map[string]ColumnLineageField `json:"fields"`
+}
+
+type ColumnLineageField struct {
+	InputFields []InputField `json:"inputFields"`
+}
+
+type InputField struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Field     string `json:"field"`
+}
+
+// Namespace is the namespace of the jobs and datasets of a Tableau site, e.g. tableau://prod-uk-a.online.tableau.com/synqtest.
+func Namespace(tableauUrl, site string) string {
+	host := tableauUrl
+	if u, err := url.Parse(tableauUrl); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	if site == "" {
+		return fmt.Sprintf("tableau://%s", host)
+	}
+	return fmt.Sprintf("tableau://%s/%s", host, site)
+}
+
+// TableDataset names a warehouse table, the namespace is made of its connection type and database,
+// e.g. snowflake://analytics with the name public.orders.
+func TableDataset(connectionType, database, fullName string) Dataset {
+	id := warehouse.Split(connectionType, database, fullName)
+	name := warehouse.Identifier{Schema: id.Schema, Name: id.Name}.String()
+	return Dataset{Namespace: fmt.Sprintf("%s://%s", connectionType, id.Database), Name: name}
+}
+
+type named interface {
+	GetName() string
+}
+
+func nameOf(n named) string {
+	if n == nil {
+		return ""
+	}
+	return n.GetName()
+}
+
+// Events converts response into one COMPLETE event for every published datasource, embedded
+// datasource, workbook and flow, all of them happening at the time the export was extracted.
+func Events(response *model.Response, namespace string) ([]RunEvent, error) {
+	c := newConverter(response, namespace)
+
+	events := make([]RunEvent, 0, len(response.PublishedDatasources)+len(response.EmbeddedDatasources)+len(response.Workbooks)+len(response.Flows))
+	for _, datasource := range response.PublishedDatasources {
+		inputs := make([]string, 0)
+		for _, table := range datasource.UpstreamTables {
+			inputs = append(inputs, c.table(table.Id, table.ConnectionType, nameOf(table.Database), table.FullName))
+		}
+		for _, upstream := range datasource.UpstreamDatasources {
+			inputs = append(inputs, upstream.Id)
+		}
+		fields := make([]SchemaField, 0, len(datasource.Fields))
+		for _, field := range datasource.Fields {
+			fields = append(fields, schemaField(field))
+		}
+		events = append(events, c.event("DATASOURCE", datasource.Id, inputs, fields))
+	}
+	for _, datasource := range response.EmbeddedDatasources {
+		inputs := make([]string, 0)
+		for _, table := range datasource.UpstreamTables {
+			inputs = append(inputs, c.table(table.Id, table.ConnectionType, nameOf(table.Database), table.FullName))
+		}
+		for _, upstream := range datasource.UpstreamDatasources {
+			inputs = append(inputs, upstream.Id)
+		}
+		fields := make([]SchemaField, 0, len(datasource.Fields))
+		for _, field := range datasource.Fields {
+			fields = append(fields, schemaField(field))
+		}
+		events = append(events, c.event("DATASOURCE", datasource.Id, inputs, fields))
+	}
+	sheets := map[string][]SchemaField{}
+	for _, sheet := range response.Sheets {
+		sheets[sheet.Workbook.Id] = append(sheets[sheet.Workbook.Id], SchemaField{Name: sheet.Name, Type: sheet.Typename})
+	}
+	for _, dashboard := range response.Dashboards {
+		sheets[dashboard.Workbook.Id] = append(sheets[dashboard.Workbook.Id], SchemaField{Name: dashboard.Name, Type: dashboard.Typename})
+	}
+	for _, workbook := range response.Workbooks {
+		inputs := make([]string, 0)
+		for _, table := range workbook.UpstreamTables {
+			inputs = append(inputs, c.table(table.Id, table.ConnectionType, nameOf(table.Database), table.FullName))
+		}
+		for _, upstream := range workbook.UpstreamDatasources {
+			inputs = append(inputs, upstream.Id)
+		}
+		for _, embedded := range workbook.EmbeddedDatasources {
+			inputs = append(inputs, embedded.Id)
+		}
+		events = append(events, c.event("WORKBOOK", workbook.Id, inputs, sheets[workbook.Id]))
+	}
+	for _, flow := range response.Flows {
+		inputs := make([]string, 0)
+		for _, table := range flow.UpstreamTables {
+			inputs = append(inputs, c.table(table.Id, table.ConnectionType, nameOf(table.Database), table.FullName))
+		}
+		for _, upstream := range flow.UpstreamDatasources {
+			inputs = append(inputs, upstream.Id)
+		}
+		outputs := make([]Dataset, 0)
+		for _, table := range flow.DownstreamTables {
+			outputs = append(outputs, c.datasets[c.table(table.Id, table.ConnectionType, nameOf(table.Database), table.FullName)])
+		}
+		for _, downstream := range flow.DownstreamDatasources {
+			if dataset, ok := c.datasets[downstream.Id]; ok {
+				outputs = append(outputs, dataset)
+			}
+		}
+		// outputs Tableau does not know, like files, are datasets named after the output step
+		if len(outputs) == 0 {
+			for _, step := range flow.OutputSteps {
+				output := c.tableauDataset("flow", flow.ProjectName, flow.Name, step.Name)
+				fields := make([]SchemaField, 0, len(step.OutputFields))
+				for _, field := range step.OutputFields {
+					fields = append(fields, SchemaField{Name: nameOf(field)})
+				}
+				output.Facets.Schema = schemaFacet(fields)
+				outputs = append(outputs, output)
+			}
+		}
+		events = append(events, c.run("FLOW", c.tableauDataset("flow", flow.ProjectName, flow.Name).Name, inputs, outputs))
+	}
+
+	for i := range events {
+		runId, err := newRunId()
+		if err != nil {
+			return nil, err
+		}
+		events[i].Run.RunId = runId
+	}
+	return events, nil
+}
+
+type converter struct {
+	namespace   string
+	extractedAt time.Time
+	// datasets are the tables and Tableau content by id
+	datasets map[string]Dataset
+	// upstream are the sources of column lineage by the id of their target
+	upstream map[string][]lineage.Node
+	// targets are the targets of column lineage by the id of their parent, e.g. the fields of a datasource
+	targets map[string][]lineage.Node
+}
+
+func newConverter(response *model.Response, namespace string) *converter {
+	c := &converter{namespace: namespace, extractedAt: response.ExtractedAt, datasets: map[string]Dataset{}, upstream: map[string][]lineage.Node{}, targets: map[string][]lineage.Node{}}
+
+	for _, table := range response.DatabaseTables {
+		dataset := TableDataset(table.ConnectionType, nameOf(table.Database), table.FullName)
+		fields := make([]SchemaField, 0, len(table.Columns))
+		for _, column := range table.Columns {
+			fields = append(fields, SchemaField{Name: column.Name, Type: string(column.RemoteType)})
+		}
+		dataset.Facets.Schema = schemaFacet(fields)
+		c.datasets[table.Id] = dataset
+	}
+	for _, datasource := range response.PublishedDatasources {
+		c.datasets[datasource.Id] = c.tableauDataset("datasource", datasource.ProjectName, datasource.Name)
+	}
+	for _, workbook := range response.Workbooks {
+		c.datasets[workbook.Id] = c.tableauDataset("workbook", workbook.ProjectName, workbook.Name)
+	}
+	for _, datasource := range response.EmbeddedDatasources {
+		c.datasets[datasource.Id] = c.tableauDataset("workbook", datasource.Workbook.ProjectName, datasource.Workbook.Name, "datasource", datasource.Name)
+	}
+
+	for _, edge := range response.ColumnLineage {
+		if _, ok := c.upstream[edge.Target.Id]; !ok {
+			c.targets[edge.Target.ParentId] = append(c.targets[edge.Target.ParentId], edge.Target)
+		}
+		c.upstream[edge.Target.Id] = append(c.upstream[edge.Target.Id], edge.Source)
+	}
+	return c
+}
+
+func (c *converter) tableauDataset(path ...string) Dataset {
+	return Dataset{Namespace: c.namespace, Name: strings.Join(path, "/")}
+}
+
+// table registers an upstream table which might have been left out of the export and returns its id.
+func (c *converter) table(id, connectionType, database, fullName string) string {
+	if _, ok := c.datasets[id]; !ok {
+		c.datasets[id] = TableDataset(connectionType, database, fullName)
+	}
+	return id
+}
+
+func (c *converter) event(jobType, id string, inputIds []string, fields []SchemaField) RunEvent {
+	output := c.datasets[id]
+	output.Facets = DatasetFacets{Schema: schemaFacet(fields), ColumnLineage: c.columnLineage(id, inputIds)}
+
+	return c.run(jobType, output.Name, inputIds, []Dataset{output})
+}
+
+func (c *converter) run(jobType, name string, inputIds []string, outputs []Dataset) RunEvent {
+	inputs := make([]Dataset, 0, len(inputIds))
+	seen := map[string]bool{}
+	for _, inputId := range inputIds {
+		if dataset, ok := c.datasets[inputId]; ok && !seen[inputId] {
+			seen[inputId] = true
+			inputs = append(inputs, dataset)
+		}
+	}
+
+	return RunEvent{
+		EventType: "COMPLETE",
+		EventTime: c.extractedAt,
+		Job: Job{
+			Namespace: c.namespace,
+			Name:      name,
+			Facets: JobFacets{JobType: &JobTypeFacet{
+				Facet:          Facet{Producer: Producer, SchemaURL: jobTypeFacetURL},
+				ProcessingType: "BATCH",
+				Integration:    "TABLEAU",
+				JobType:        jobType,
+			}},
+		},
+		Inputs:    inputs,
+		Outputs:   outputs,
+		Producer:  Producer,
+		SchemaURL: SchemaURL,
+	}
+}
+
+// columnLineage follows the lineage of every field or sheet of the content with the given id upstream,
+// through calculated fields, until it reaches fields of its inputs.
+func (c *converter) columnLineage(id string, inputIds []string) *ColumnLineageFacet {
+	inputs := toSet(inputIds)
+	fields := map[string]ColumnLineageField{}
+	for _, target := range c.targets[id] {
+		inputFields := c.inputFields(target.Id, inputs, map[string]bool{})
+		if len(inputFields) == 0 {
+			continue
+		}
+		fields[target.Name] = ColumnLineageField{InputFields: uniqueInputFields(append(fields[target.Name].InputFields, inputFields...))}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ColumnLineageFacet{Facet: Facet{Producer: Producer, SchemaURL: columnLineageFacetURL}, Fields: fields}
+}
+
+func (c *converter) inputFields(target string, inputs map[string]bool, visited map[string]bool) []InputField {
+	if visited[target] {
+		return nil
+	}
+	visited[target] = true
+
+	result := make([]InputField, 0)
+	for _, source := range c.upstream[target] {
+		if inputs[source.ParentId] {
+			dataset := c.datasets[source.ParentId]
+			result = append(result, InputField{Namespace: dataset.Namespace, Name: dataset.Name, Field: source.Name})
+		} else {
+			result = append(result, c.inputFields(source.Id, inputs, visited)...)
+		}
+	}
+	return result
+}
+
+func uniqueInputFields(fields []InputField) []InputField {
+	sort.Slice(fields, func(i, j int) bool {
+		a, b := fields[i], fields[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Field < b.Field
+	})
+	unique := fields[:0]
+	for i, field := range fields {
+		if i == 0 || field != fields[i-1] {
+			unique = append(unique, field)
+		}
+	}
+	return unique
+}
+
+func schemaFacet(fields []SchemaField) *SchemaFacet {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &SchemaFacet{Facet: Facet{Producer: Producer, SchemaURL: schemaFacetURL}, Fields: fields}
+}
+
+type field interface {
+	GetName() string
+	GetDescription() string
+}
+
+func schemaField(f field) SchemaField {
+	result := SchemaField{Name: f.GetName(), Description: f.GetDescription()}
+	if typed, ok := f.(interface{ GetDataType() metadata.FieldDataType }); ok {
+		result.Type = string(typed.GetDataType())
+	}
+	return result
+}
+
+func toSet(ids []string) map[string]bool {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
+// newRunId returns a random version 4 UUID.
+func newRunId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
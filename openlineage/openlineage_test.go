@@ -0,0 +1,146 @@
+package openlineage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/internal/fixture"
+	"github.com/getsynq/connections-tableau/metadata"
+)
+
+func TestEvents(t *testing.T) {
+	events, err := Events(fixture.Export(t), Namespace("https://prod-uk-a.online.tableau.com/", "synqtest"))
+	if err != nil {
+		t.Fatalf("Events() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Events() returned %d events, want 2", len(events))
+	}
+	if events[0].Run.RunId == events[1].Run.RunId || len(events[0].Run.RunId) != 36 {
+		t.Errorf("run IDs %s and %s are not unique UUIDs", events[0].Run.RunId, events[1].Run.RunId)
+	}
+
+	const tableau = "tableau://prod-uk-a.online.tableau.com/synqtest"
+	datasource := events[0]
+	if datasource.Job.Name != "datasource/Finance/Orders" || datasource.Job.Namespace != tableau {
+		t.Errorf("datasource job = %+v", datasource.Job)
+	}
+	if len(datasource.Inputs) != 1 || datasource.Inputs[0].Namespace != "snowflake://analytics" || datasource.Inputs[0].Name != "public.orders" {
+		t.Errorf("datasource inputs = %+v", datasource.Inputs)
+	}
+	if schema := datasource.Inputs[0].Facets.Schema; schema == nil || !reflect.DeepEqual(schema.Fields, []SchemaField{{Name: "id", Type: "I8"}, {Name: "note", Type: "WSTR"}}) {
+		t.Errorf("table schema = %+v", schema)
+	}
+	if schema := datasource.Outputs[0].Facets.Schema; schema == nil || !reflect.DeepEqual(schema.Fields, []SchemaField{{Name: "Id", Type: "INTEGER"}}) {
+		t.Errorf("datasource schema = %+v", schema)
+	}
+	orderId := []InputField{{Namespace: "snowflake://analytics", Name: "public.orders", Field: "id"}}
+	wantDatasourceLineage := map[string]ColumnLineageField{"Id": {InputFields: orderId}, "Id Count": {InputFields: orderId}}
+	if got := datasource.Outputs[0].Facets.ColumnLineage; got == nil || !reflect.DeepEqual(got.Fields, wantDatasourceLineage) {
+		t.Errorf("datasource column lineage = %+v, want %+v", got, wantDatasourceLineage)
+	}
+
+	workbook := events[1]
+	if len(workbook.Inputs) != 1 || workbook.Inputs[0].Namespace != tableau || workbook.Inputs[0].Name != "datasource/Finance/Orders" {
+		t.Errorf("workbook inputs = %+v", workbook.Inputs)
+	}
+	// the lineage of sheets stops at the fields of the datasource the workbook reads
+	wantWorkbookLineage := map[string]ColumnLineageField{"Revenue": {InputFields: []InputField{{Namespace: tableau, Name: "datasource/Finance/Orders", Field: "Id Count"}}}}
+	if got := workbook.Outputs[0].Facets.ColumnLineage; got == nil || !reflect.DeepEqual(got.Fields, wantWorkbookLineage) {
+		t.Errorf("workbook column lineage = %+v, want %+v", got, wantWorkbookLineage)
+	}
+}
+
+func TestEventsFlows(t *testing.T) {
+	response := fixture.Export(t)
+	response.Workbooks, response.Sheets = nil, nil
+	response.Flows = []metadata.GetFlowsFlowsConnectionNodesFlow{
+		{
+			Id: "f1", Name: "Clean Orders", ProjectName: "Finance",
+			UpstreamTables: []metadata.GetFlowsFlowsConnectionNodesFlowUpstreamTablesDatabaseTable{{Id: "t1"}},
+			DownstreamTables: []metadata.GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTable{{
+				Id: "t2", FullName: "[public].[clean_orders]", ConnectionType: "snowflake",
+				Database: &metadata.GetFlowsFlowsConnectionNodesFlowDownstreamTablesDatabaseTableDatabaseDatabaseServer{Name: "analytics"},
+			}},
+			DownstreamDatasources: []metadata.GetFlowsFlowsConnectionNodesFlowDownstreamDatasourcesPublishedDatasource{{Id: "p1"}},
+		},
+		{
+			Id: "f2", Name: "Export", ProjectName: "Finance",
+			UpstreamDatasources: []metadata.GetFlowsFlowsConnectionNodesFlowUpstreamDatasourcesPublishedDatasource{{Id: "p1"}},
+			OutputSteps: []metadata.GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStep{{
+				Name: "orders.csv",
+				OutputFields: []metadata.GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowOutputField{
+					&metadata.GetFlowsFlowsConnectionNodesFlowOutputStepsFlowOutputStepOutputFieldsFlowColumnOutputField{Name: "Id"},
+				},
+			}},
+		},
+	}
+
+	events, err := Events(response, Namespace("https://prod-uk-a.online.tableau.com/", "synqtest"))
+	if err != nil {
+		t.Fatalf("Events() error = %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Events() returned %d events, want 3", len(events))
+	}
+
+	const tableau = "tableau://prod-uk-a.online.tableau.com/synqtest"
+	names := func(datasets []Dataset) []string {
+		result := make([]string, 0, len(datasets))
+		for _, dataset := range datasets {
+			result = append(result, dataset.Namespace+"/"+dataset.Name)
+		}
+		return result
+	}
+	clean := events[1]
+	if clean.Job.Name != "flow/Finance/Clean Orders" || clean.Job.Facets.JobType.JobType != "FLOW" {
+		t.Errorf("flow job = %+v", clean.Job)
+	}
+	if got, want := names(clean.Inputs), []string{"snowflake://analytics/public.orders"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("flow inputs = %v, want %v", got, want)
+	}
+	if got, want := names(clean.Outputs), []string{"snowflake://analytics/public.clean_orders", tableau + "/datasource/Finance/Orders"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("flow outputs = %v, want %v", got, want)
+	}
+
+	export := events[2]
+	if got, want := names(export.Outputs), []string{tableau + "/flow/Finance/Export/orders.csv"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("flow outputs = %v, want %v", got, want)
+	}
+	if schema := export.Outputs[0].Facets.Schema; schema == nil || !reflect.DeepEqual(schema.Fields, []SchemaField{{Name: "Id"}}) {
+		t.Errorf("output step schema = %+v", schema)
+	}
+}
+
+func TestClientEmit(t *testing.T) {
+	var received []RunEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q", got)
+		}
+		var event RunEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("invalid event: %v", err)
+		}
+		received = append(received, event)
+		if event.Job.Name == "rejected" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{Endpoint: server.URL, ApiKey: "secret", HttpClient: server.Client()}
+	if err := client.Emit(context.Background(), []RunEvent{{Job: Job{Name: "a"}}, {Job: Job{Name: "b"}}}); err != nil {
+		t.Fatalf("Emit() error = %v", err)
+	}
+	if len(received) != 2 {
+		t.Errorf("server received %d events, want 2", len(received))
+	}
+	if err := client.Emit(context.Background(), []RunEvent{{Job: Job{Name: "rejected"}}}); err == nil {
+		t.Errorf("Emit() expected an error for a rejected event")
+	}
+}
@@ -0,0 +1,70 @@
+package output
+
+import "github.com/getsynq/connections-tableau/model"
+
+// ColumnRow is a single column of a database or custom SQL table, flattened for CSV and Parquet.
+// Tables without columns are written as a single row with empty column fields.
+type ColumnRow struct {
+	TableId          string `csv:"tableId" parquet:"name=tableId, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TableType        string `csv:"tableType" parquet:"name=tableType, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ConnectionType   string `csv:"connectionType" parquet:"name=connectionType, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Database         string `csv:"database" parquet:"name=database, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Schema           string `csv:"schema" parquet:"name=schema, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Table            string `csv:"table" parquet:"name=table, type=BYTE_ARRAY, convertedtype=UTF8"`
+	FullName         string `csv:"fullName" parquet:"name=fullName, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Identifier       string `csv:"identifier" parquet:"name=identifier, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ProjectName      string `csv:"projectName" parquet:"name=projectName, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TableDescription string `csv:"tableDescription" parquet:"name=tableDescription, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ColumnId         string `csv:"columnId" parquet:"name=columnId, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Column           string `csv:"column" parquet:"name=column, type=BYTE_ARRAY, convertedtype=UTF8"`
+	RemoteType       string `csv:"remoteType" parquet:"name=remoteType, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+func columnRows(response *model.Response) []*ColumnRow {
+	rows := make([]*ColumnRow, 0)
+	for _, table := range response.DatabaseTables {
+		row := ColumnRow{
+			TableId:          table.Id,
+			TableType:        table.Typename,
+			ConnectionType:   table.ConnectionType,
+			Schema:           table.Schema,
+			Table:            table.Name,
+			FullName:         table.FullName,
+			ProjectName:      table.ProjectName,
+			TableDescription: table.Description,
+		}
+		if table.Database != nil {
+			row.Database = table.Database.GetName()
+		}
+		row.Identifier = table.Identifier.Canonical
+		if len(table.Columns) == 0 {
+			rows = append(rows, &row)
+		}
+		for _, column := range table.Columns {
+			columnRow := row
+			columnRow.ColumnId, columnRow.Column, columnRow.RemoteType = column.Id, column.Name, string(column.RemoteType)
+			rows = append(rows, &columnRow)
+		}
+	}
+	for _, table := range response.CustomSQLTables {
+		row := ColumnRow{
+			TableId:          table.Id,
+			TableType:        table.Typename,
+			ConnectionType:   table.ConnectionType,
+			Table:            table.Name,
+			TableDescription: table.Description,
+		}
+		if table.Database != nil {
+			row.Database = table.Database.GetName()
+		}
+		if len(table.Columns) == 0 {
+			rows = append(rows, &row)
+		}
+		for _, column := range table.Columns {
+			columnRow := row
+			columnRow.ColumnId, columnRow.Column, columnRow.RemoteType = column.Id, column.Name, string(column.RemoteType)
+			rows = append(rows, &columnRow)
+		}
+	}
+	return rows
+}
@@ -0,0 +1,42 @@
+package output
+
+import (
+	"encoding/csv"
+	"io"
+	"reflect"
+
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// csvWriter writes one row per column of every database and custom SQL table.
+type csvWriter struct {
+	w io.Writer
+}
+
+func (c *csvWriter) WritePage(entity string, nodes interface{}) error {
+	return nil
+}
+
+func (c *csvWriter) Write(response *model.Response) error {
+	w := csv.NewWriter(c.w)
+	rowType := reflect.TypeOf(ColumnRow{})
+	header := make([]string, rowType.NumField())
+	for i := range header {
+		header[i] = rowType.Field(i).Tag.Get("csv")
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	for _, row := range columnRows(response) {
+		value := reflect.ValueOf(row).Elem()
+		record := make([]string, value.NumField())
+		for i := range record {
+			record[i] = value.Field(i).String()
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,189 @@
+package output
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	CompressionNone = ""
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+
+	// Stdout as destination writes the export to standard output instead of a file.
+	Stdout = "-"
+
+	DefaultFileName = "{entity}-{site}-{timestamp}.{ext}"
+)
+
+// Destination is where an export is written to.
+type Destination struct {
+	// Path is a file, an existing directory or Stdout.
+	Path string
+	// FileName is the template of the file name used when Path is a directory, see Name.
+	FileName string
+	// Compression is CompressionNone, CompressionGzip or CompressionZstd.
+	Compression string
+}
+
+// Name expands the placeholders {entity}, {site}, {timestamp}, {format} and {ext} of the file name
+// template. The default site is called "default" and colons in the timestamp are replaced to keep the
+// name valid on every OS.
+func (d *Destination) Name(format, site string, now time.Time) string {
+	if site == "" {
+		site = "default"
+	}
+	return strings.NewReplacer(
+		"{entity}", Entity(format),
+		"{site}", site,
+		"{timestamp}", strings.ReplaceAll(now.UTC().Format(time.RFC3339), ":", "_"),
+		"{format}", format,
+		"{ext}", format+compressionExtension[d.Compression],
+	).Replace(d.FileName)
+}
+
+var compressionExtension = map[string]string{
+	CompressionNone: "",
+	CompressionGzip: ".gz",
+	CompressionZstd: ".zst",
+}
+
+// Entity names what an export in the format contains, it fills the {entity} placeholder of file names.
+func Entity(format string) string {
+	switch format {
+	case FormatCSV, FormatParquet:
+		return "columns"
+	case FormatOpenLineage:
+		return "lineage"
+	case FormatDataHub, FormatOpenMetadata:
+		return "catalog"
+	}
+	return "tables"
+}
+
+func (d *Destination) Validate() error {
+	if _, ok := compressionExtension[d.Compression]; !ok {
+		return fmt.Errorf("unknown compression %s, expected gzip or zstd", d.Compression)
+	}
+	return nil
+}
+
+// Open starts writing a new export. Files are written to a temporary file next to the destination,
+// which only replaces it on Commit, so a failed crawl never leaves a partial export behind.
+func (d *Destination) Open(format, site string, now time.Time) (*File, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+
+	f := &File{Name: d.Path}
+	if d.Path == Stdout {
+		f.w = os.Stdout
+	} else {
+		if d.Path == "" || strings.HasSuffix(d.Path, string(os.PathSeparator)) || isDir(d.Path) {
+			f.Name = filepath.Join(d.Path, d.Name(format, site, now))
+		}
+		if err := os.MkdirAll(filepath.Dir(f.Name), 0755); err != nil {
+			return nil, err
+		}
+		file, err := os.CreateTemp(filepath.Dir(f.Name), "."+filepath.Base(f.Name)+".*.tmp")
+		if err != nil {
+			return nil, err
+		}
+		f.file, f.w = file, file
+		if err := file.Chmod(0644); err != nil {
+			f.Abort()
+			return nil, err
+		}
+	}
+
+	var err error
+	switch d.Compression {
+	case CompressionGzip:
+		f.compressor = gzip.NewWriter(f.w)
+	case CompressionZstd:
+		f.compressor, err = zstd.NewWriter(f.w)
+	}
+	if err != nil {
+		f.Abort()
+		return nil, err
+	}
+	if f.compressor != nil {
+		f.w = f.compressor
+	}
+	return f, nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// File is an export being written, it must be finished with either Commit or Abort.
+type File struct {
+	// Name is the final path of the file or Stdout.
+	Name       string
+	w          io.Writer
+	compressor io.WriteCloser
+	file       *os.File
+}
+
+func (f *File) Write(p []byte) (int, error) {
+	return f.w.Write(p)
+}
+
+// Commit flushes the export and moves it into place.
+func (f *File) Commit() error {
+	if f.compressor != nil {
+		if err := f.compressor.Close(); err != nil {
+			f.Abort()
+			return err
+		}
+	}
+	if f.file == nil {
+		return nil
+	}
+	if err := f.file.Sync(); err != nil {
+		f.Abort()
+		return err
+	}
+	if err := f.file.Close(); err != nil {
+		os.Remove(f.file.Name())
+		return err
+	}
+	if err := os.Rename(f.file.Name(), f.Name); err != nil {
+		os.Remove(f.file.Name())
+		return err
+	}
+	return nil
+}
+
+// Abort discards the export, it is a no-op after Commit.
+func (f *File) Abort() {
+	if f.file == nil {
+		return
+	}
+	f.file.Close()
+	os.Remove(f.file.Name())
+}
+
+// Decompress wraps r to decompress it if name has the extension of a compressed export.
+func Decompress(name string, r io.Reader) (io.ReadCloser, error) {
+	switch {
+	case strings.HasSuffix(name, compressionExtension[CompressionGzip]):
+		return gzip.NewReader(r)
+	case strings.HasSuffix(name, compressionExtension[CompressionZstd]):
+		decoder, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	}
+	return io.NopCloser(r), nil
+}
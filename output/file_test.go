@@ -0,0 +1,93 @@
+package output
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDestinationName(t *testing.T) {
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		destination Destination
+		format      string
+		site        string
+		want        string
+	}{
+		{Destination{FileName: DefaultFileName}, FormatJSON, "synqtest", "tables-synqtest-2023-01-02T03_04_05Z.json"},
+		{Destination{FileName: DefaultFileName, Compression: CompressionGzip}, FormatCSV, "", "columns-default-2023-01-02T03_04_05Z.csv.gz"},
+		{Destination{FileName: "{site}/{format}.{ext}", Compression: CompressionZstd}, FormatNDJSON, "synqtest", "synqtest/ndjson.ndjson.zst"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.destination.Name(tt.format, tt.site, now); got != tt.want {
+				t.Errorf("Name() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDestinationOpen(t *testing.T) {
+	for _, compression := range []string{CompressionNone, CompressionGzip, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			dir := t.TempDir()
+			destination := Destination{Path: dir, FileName: "export.{ext}", Compression: compression}
+
+			file, err := destination.Open(FormatJSON, "", time.Now())
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			if _, err := file.Write([]byte(`{"databaseTables":[]}`)); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			// nothing is visible under the final name before the export is committed
+			if _, err := os.Stat(file.Name); !os.IsNotExist(err) {
+				t.Errorf("%s exists before Commit()", file.Name)
+			}
+			if err := file.Commit(); err != nil {
+				t.Fatalf("Commit() error = %v", err)
+			}
+			file.Abort()
+
+			entries, _ := os.ReadDir(dir)
+			if len(entries) != 1 || filepath.Join(dir, entries[0].Name()) != file.Name {
+				t.Fatalf("directory contains %v, want only %s", entries, file.Name)
+			}
+
+			f, err := os.Open(file.Name)
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			defer f.Close()
+			r, err := Decompress(file.Name, f)
+			if err != nil {
+				t.Fatalf("Decompress() error = %v", err)
+			}
+			got, err := io.ReadAll(r)
+			if err != nil || string(got) != `{"databaseTables":[]}` {
+				t.Errorf("read back %q, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestDestinationAbort(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tables.json")
+	destination := Destination{Path: path}
+
+	file, err := destination.Open(FormatJSON, "", time.Now())
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if file.Name != path {
+		t.Errorf("Name = %s, want %s", file.Name, path)
+	}
+	file.Abort()
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Abort() left %v behind", entries)
+	}
+}
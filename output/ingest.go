@@ -0,0 +1,46 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/getsynq/connections-tableau/ingest"
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// dataHubWriter writes a JSON array of DataHub metadata change proposals.
+type dataHubWriter struct {
+	w      io.Writer
+	source Source
+}
+
+func (d *dataHubWriter) WritePage(entity string, nodes interface{}) error {
+	return nil
+}
+
+func (d *dataHubWriter) Write(response *model.Response) error {
+	return writeIndented(d.w, ingest.ToDataHub(response, d.source.Url, d.source.Site))
+}
+
+// openMetadataWriter writes the OpenMetadata create requests of tables, charts, dashboards and lineage.
+type openMetadataWriter struct {
+	w      io.Writer
+	source Source
+}
+
+func (o *openMetadataWriter) WritePage(entity string, nodes interface{}) error {
+	return nil
+}
+
+func (o *openMetadataWriter) Write(response *model.Response) error {
+	return writeIndented(o.w, ingest.ToOpenMetadata(response, o.source.Url, o.source.Site))
+}
+
+func writeIndented(w io.Writer, v interface{}) error {
+	jsonBytes, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(jsonBytes)
+	return err
+}
@@ -0,0 +1,26 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// jsonWriter writes the whole export as a single indented JSON document.
+type jsonWriter struct {
+	w io.Writer
+}
+
+func (j *jsonWriter) WritePage(entity string, nodes interface{}) error {
+	return nil
+}
+
+func (j *jsonWriter) Write(response *model.Response) error {
+	jsonBytes, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = j.w.Write(jsonBytes)
+	return err
+}
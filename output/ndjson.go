@@ -0,0 +1,68 @@
+package output
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// ndjsonWriter writes one line per node, streaming pages as they are downloaded. Entities which were
+// not streamed, like lineage built at the end of the crawl, are written once the crawl has finished.
+type ndjsonWriter struct {
+	w        *bufio.Writer
+	encoder  *json.Encoder
+	streamed map[string]bool
+}
+
+type ndjsonLine struct {
+	Entity string      `json:"entity"`
+	Data   interface{} `json:"data"`
+}
+
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	buffered := bufio.NewWriter(w)
+	return &ndjsonWriter{w: buffered, encoder: json.NewEncoder(buffered), streamed: map[string]bool{}}
+}
+
+func (n *ndjsonWriter) WritePage(entity string, nodes interface{}) error {
+	n.streamed[entity] = true
+	if err := n.writeNodes(entity, reflect.ValueOf(nodes)); err != nil {
+		return err
+	}
+	return n.w.Flush()
+}
+
+func (n *ndjsonWriter) Write(response *model.Response) error {
+	for _, e := range entities(response) {
+		if n.streamed[e.name] {
+			continue
+		}
+		if err := n.writeNodes(e.name, e.nodes); err != nil {
+			return err
+		}
+	}
+	if err := n.encoder.Encode(ndjsonLine{Entity: "export", Data: struct {
+		ExtractedAt time.Time `json:"extractedAt"`
+	}{response.ExtractedAt}}); err != nil {
+		return err
+	}
+	return n.w.Flush()
+}
+
+func (n *ndjsonWriter) writeNodes(entity string, nodes reflect.Value) error {
+	for i := 0; i < nodes.Len(); i++ {
+		node := nodes.Index(i)
+		// the generated MarshalJSON of nodes with interface fields has a pointer receiver
+		if node.Kind() != reflect.Ptr {
+			node = node.Addr()
+		}
+		if err := n.encoder.Encode(ndjsonLine{Entity: entity, Data: node.Interface()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package output
+
+import (
+	"io"
+
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/getsynq/connections-tableau/openlineage"
+)
+
+// openLineageWriter writes one OpenLineage run event per line for every workbook and datasource.
+type openLineageWriter struct {
+	w         io.Writer
+	namespace string
+}
+
+func (o *openLineageWriter) WritePage(entity string, nodes interface{}) error {
+	return nil
+}
+
+func (o *openLineageWriter) Write(response *model.Response) error {
+	events, err := openlineage.Events(response, o.namespace)
+	if err != nil {
+		return err
+	}
+	return openlineage.Write(o.w, events)
+}
@@ -0,0 +1,96 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/getsynq/connections-tableau/openlineage"
+)
+
+const (
+	FormatJSON         = "json"
+	FormatNDJSON       = "ndjson"
+	FormatCSV          = "csv"
+	FormatParquet      = "parquet"
+	FormatOpenLineage  = "openlineage"
+	FormatDataHub      = "datahub"
+	FormatOpenMetadata = "openmetadata"
+)
+
+var Formats = []string{FormatJSON, FormatNDJSON, FormatCSV, FormatParquet, FormatOpenLineage, FormatDataHub, FormatOpenMetadata}
+
+// Source is the Tableau site an export was downloaded from.
+type Source struct {
+	Url  string
+	Site string
+}
+
+// Writer writes an export in one of the supported formats.
+type Writer interface {
+	// WritePage is called with every page of an entity, named like its field in the JSON export, as soon
+	// as it has been downloaded. Writers which cannot stream ignore it.
+	WritePage(entity string, nodes interface{}) error
+	// Write is called once with the complete export after the crawl has finished.
+	Write(response *model.Response) error
+}
+
+// Streams reports whether the writers of format write the pages passed to WritePage, so that the crawl
+// does not have to keep them for Write.
+func Streams(format string) bool {
+	return format == FormatNDJSON
+}
+
+func New(format string, w io.Writer, source Source) (Writer, error) {
+	switch format {
+	case FormatJSON:
+		return &jsonWriter{w: w}, nil
+	case FormatNDJSON:
+		return newNDJSONWriter(w), nil
+	case FormatCSV:
+		return &csvWriter{w: w}, nil
+	case FormatParquet:
+		return &parquetWriter{w: w}, nil
+	case FormatOpenLineage:
+		return &openLineageWriter{w: w, namespace: openlineage.Namespace(source.Url, source.Site)}, nil
+	case FormatDataHub:
+		return &dataHubWriter{w: w, source: source}, nil
+	case FormatOpenMetadata:
+		return &openMetadataWriter{w: w, source: source}, nil
+	}
+	return nil, fmt.Errorf("unknown format %s, expected one of %v", format, Formats)
+}
+
+// entity is a list of nodes of the export together with its name in the JSON export.
+type entity struct {
+	name  string
+	nodes reflect.Value
+}
+
+// entities lists every slice field of the export in the order they are declared.
+func entities(response *model.Response) []entity {
+	value := reflect.ValueOf(response).Elem()
+	result := make([]entity, 0, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Type.Kind() != reflect.Slice {
+			continue
+		}
+		result = append(result, entity{name: jsonName(field), nodes: value.Field(i)})
+	}
+	return result
+}
+
+func jsonName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	for i := 0; i < len(tag); i++ {
+		if tag[i] == ',' {
+			return tag[:i]
+		}
+	}
+	if tag == "" {
+		return field.Name
+	}
+	return tag
+}
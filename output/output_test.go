@@ -0,0 +1,99 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/internal/fixture"
+	"github.com/getsynq/connections-tableau/metadata"
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// export adds a table without database, schema and columns and with a name which needs quoting in
+// CSV to the fixture.
+func export(t *testing.T) *model.Response {
+	response := fixture.Export(t)
+	response.DatabaseTables = append(response.DatabaseTables, model.DatabaseTables(
+		&metadata.GetDatabaseTablesDefinitionsDatabaseTablesConnectionNodesDatabaseTable{Id: "t2", Typename: "DatabaseTable", ConnectionType: "snowflake", Name: "empty, \"quoted\""},
+	)...)
+	return response
+}
+
+func TestCSV(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := New(FormatCSV, &buf, Source{})
+	if err := w.Write(export(t)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	want := `tableId,tableType,connectionType,database,schema,table,fullName,identifier,projectName,tableDescription,columnId,column,remoteType
+t1,DatabaseTable,snowflake,analytics,public,orders,[public].[orders],ANALYTICS.PUBLIC.ORDERS,,,c1,id,I8
+t1,DatabaseTable,snowflake,analytics,public,orders,[public].[orders],ANALYTICS.PUBLIC.ORDERS,,,c2,note,WSTR
+t2,DatabaseTable,snowflake,,,"empty, ""quoted""",,,,,,,
+q1,CustomSQLTable,snowflake,,,Custom SQL Query,,,,,,,
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Write() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := New(FormatNDJSON, &buf, Source{})
+	response := export(t)
+	if err := w.WritePage("databaseTables", response.DatabaseTables[:1]); err != nil {
+		t.Fatalf("WritePage() error = %v", err)
+	}
+	// the first page is on its way before the crawl finishes
+	if !strings.Contains(buf.String(), `"id":"t1"`) {
+		t.Fatalf("WritePage() did not flush the page: %q", buf.String())
+	}
+	if err := w.WritePage("databaseTables", response.DatabaseTables[1:]); err != nil {
+		t.Fatalf("WritePage() error = %v", err)
+	}
+	if err := w.Write(response); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	var got []string
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line struct {
+			Entity string `json:"entity"`
+			Data   struct {
+				Id string `json:"id"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("invalid line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, line.Entity+":"+line.Data.Id)
+	}
+	want := []string{
+		"databaseTables:t1", "databaseTables:t2", "customSQLTables:q1", "customSQLReferences:", "publishedDatasources:p1",
+		"workbooks:w1", "sheets:s1", "dashboards:d1", "columnLineage:", "columnLineage:", "columnLineage:", "export:",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("lines = %v, want %v", got, want)
+	}
+}
+
+func TestParquet(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := New(FormatParquet, &buf, Source{})
+	if err := w.Write(export(t)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if got := buf.Bytes(); len(got) < 8 || string(got[:4]) != "PAR1" || string(got[len(got)-4:]) != "PAR1" {
+		t.Errorf("Write() did not produce a parquet file")
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New("xml", &bytes.Buffer{}, Source{}); err == nil {
+		t.Errorf("New() expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,32 @@
+package output
+
+import (
+	"io"
+
+	"github.com/getsynq/connections-tableau/model"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetWriter writes the same rows as csvWriter into a Snappy compressed Parquet file.
+type parquetWriter struct {
+	w io.Writer
+}
+
+func (p *parquetWriter) WritePage(entity string, nodes interface{}) error {
+	return nil
+}
+
+func (p *parquetWriter) Write(response *model.Response) error {
+	pw, err := writer.NewParquetWriterFromWriter(p.w, new(ColumnRow), 1)
+	if err != nil {
+		return err
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	for _, row := range columnRows(response) {
+		if err := pw.Write(row); err != nil {
+			return err
+		}
+	}
+	return pw.WriteStop()
+}
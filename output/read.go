@@ -0,0 +1,101 @@
+package output
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/getsynq/connections-tableau/model"
+)
+
+// FormatOf is the format of an export named after its extension, e.g. ndjson for
+// tables-site-2023-01-02T03_04_05Z.ndjson.zst, or empty if the extension is not the one of a format.
+func FormatOf(name string) string {
+	for _, extension := range compressionExtension {
+		if extension != "" {
+			name = strings.TrimSuffix(name, extension)
+		}
+	}
+	ext := strings.TrimPrefix(filepath.Ext(name), ".")
+	for _, format := range Formats {
+		if ext == format {
+			return format
+		}
+	}
+	return ""
+}
+
+// Read reads a json or ndjson export, named like the file it was read from, which has already been
+// decompressed. Exports in other formats do not contain everything and cannot be read, exports of
+// earlier versions which were a bare JSON array of database tables are read as exports with only
+// database tables.
+func Read(name string, r io.Reader) (*model.Response, error) {
+	format := FormatOf(name)
+	if format != "" && format != FormatJSON && format != FormatNDJSON {
+		return nil, fmt.Errorf("%s is a %s export, only json and ndjson exports can be read", name, format)
+	}
+
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	var first json.RawMessage
+	if err := decoder.Decode(&first); err != nil {
+		return nil, err
+	}
+	export := &model.Response{}
+	if len(first) > 0 && first[0] == '[' {
+		return export, json.Unmarshal(first, &export.DatabaseTables)
+	}
+	// without an extension, an export is ndjson if its first line is one
+	var line ndjsonLine
+	if format == FormatNDJSON || (format == "" && json.Unmarshal(first, &line) == nil && line.Entity != "") {
+		return readNDJSON(first, decoder)
+	}
+	return export, json.Unmarshal(first, export)
+}
+
+// readNDJSON collects the nodes of every line into the field of their entity up to the export line
+// which ends every complete ndjson export. Entities the export does not know are skipped.
+func readNDJSON(first json.RawMessage, decoder *json.Decoder) (*model.Response, error) {
+	export := &model.Response{}
+	fields := map[string]reflect.Value{}
+	for _, e := range entities(export) {
+		fields[e.name] = e.nodes
+	}
+
+	raw := first
+	for {
+		var line struct {
+			Entity string          `json:"entity"`
+			Data   json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(raw, &line); err != nil {
+			return nil, err
+		}
+		if line.Entity == "export" {
+			var end struct {
+				ExtractedAt json.RawMessage `json:"extractedAt"`
+			}
+			if err := json.Unmarshal(line.Data, &end); err != nil {
+				return nil, err
+			}
+			return export, json.Unmarshal(end.ExtractedAt, &export.ExtractedAt)
+		}
+		if nodes, ok := fields[line.Entity]; ok {
+			node := reflect.New(nodes.Type().Elem())
+			if err := json.Unmarshal(line.Data, node.Interface()); err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", line.Entity, err)
+			}
+			nodes.Set(reflect.Append(nodes, node.Elem()))
+		}
+
+		raw = nil
+		if err := decoder.Decode(&raw); err == io.EOF {
+			return nil, fmt.Errorf("the export ends without its export line, it is incomplete")
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
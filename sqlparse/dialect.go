@@ -0,0 +1,77 @@
+package sqlparse
+
+import "strings"
+
+type Dialect string
+
+const (
+	Generic    Dialect = "generic"
+	BigQuery   Dialect = "bigquery"
+	Snowflake  Dialect = "snowflake"
+	Redshift   Dialect = "redshift"
+	ClickHouse Dialect = "clickhouse"
+)
+
+// DialectForConnectionType maps Tableau's `connectionType` shortname onto the SQL dialect used to parse custom SQL.
+func DialectForConnectionType(connectionType string) Dialect {
+	switch strings.ToLower(connectionType) {
+	case "bigquery":
+		return BigQuery
+	case "snowflake":
+		return Snowflake
+	case "redshift":
+		return Redshift
+	case "clickhouse":
+		return ClickHouse
+	default:
+		return Generic
+	}
+}
+
+func (d Dialect) isIdentifierQuote(c byte) bool {
+	switch d {
+	case BigQuery:
+		return c == '`'
+	case Snowflake, Redshift:
+		return c == '"'
+	default:
+		return c == '"' || c == '`'
+	}
+}
+
+func (d Dialect) isStringQuote(c byte) bool {
+	if d == BigQuery {
+		return c == '\'' || c == '"'
+	}
+	return c == '\''
+}
+
+func (d Dialect) hasHashComments() bool {
+	return d == BigQuery || d == ClickHouse
+}
+
+// hasSchemas is false for dialects where a two part name is `database.table`.
+func (d Dialect) hasSchemas() bool {
+	return d != ClickHouse
+}
+
+// Fold applies the dialect's case folding rules to an identifier.
+func (d Dialect) Fold(identifier string, quoted bool) string {
+	switch d {
+	case Snowflake:
+		if !quoted {
+			return strings.ToUpper(identifier)
+		}
+	case Redshift:
+		// Redshift lowercases quoted identifiers too unless enable_case_sensitive_identifier is set
+		return strings.ToLower(identifier)
+	}
+	return identifier
+}
+
+func (d Dialect) equalIdentifiers(a, b string) bool {
+	if d == ClickHouse {
+		return a == b
+	}
+	return strings.EqualFold(a, b)
+}
@@ -0,0 +1,27 @@
+package sqlparse
+
+// reserved words are never treated as table aliases or column references
+var reserved = toSet(
+	"ALL", "AND", "ANTI", "ANY", "ARRAY", "AS", "ASC", "ASOF", "AT", "BETWEEN", "BY", "CASE", "COLLATE", "CROSS",
+	"CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER", "DESC", "DISTINCT", "ELSE", "END", "ESCAPE",
+	"EXCEPT", "EXISTS", "FALSE", "FETCH", "FILTER", "FOLLOWING", "FROM", "FULL", "GLOBAL", "GROUP", "HAVING", "IGNORE",
+	"ILIKE", "IN", "INNER", "INTERSECT", "INTERVAL", "INTO", "IS", "JOIN", "LATERAL", "LEFT", "LIKE", "LIMIT",
+	"LOCALTIME", "LOCALTIMESTAMP", "MINUS", "NATURAL", "NOT", "NULL", "NULLS", "OFFSET", "ON", "OR", "ORDER",
+	"OUTER", "OVER", "PARTITION", "PASTE", "POSITIONAL", "PRECEDING", "PREWHERE", "QUALIFY", "RECURSIVE", "REGEXP",
+	"RESPECT", "RIGHT", "RLIKE", "ROWS", "SELECT", "SEMI", "SESSION_USER", "SIMILAR", "SOME", "SYSDATE",
+	"TABLESAMPLE", "THEN", "TRUE", "UNBOUNDED", "UNION", "USING", "VALUES", "WHEN", "WHERE", "WINDOW", "WITH", "WITHIN",
+)
+
+// datePart words are skipped when passed directly as a function argument, e.g. `DATE_TRUNC(created_at, MONTH)`
+var datePart = toSet(
+	"MICROSECOND", "MILLISECOND", "SECOND", "MINUTE", "HOUR", "DAY", "DAYOFWEEK", "DAYOFYEAR", "WEEK", "ISOWEEK",
+	"MONTH", "QUARTER", "YEAR", "ISOYEAR", "EPOCH", "DATE", "TIME", "DATETIME", "TIMESTAMP",
+)
+
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
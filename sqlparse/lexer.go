@@ -0,0 +1,179 @@
+package sqlparse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenQuotedIdent
+	tokenString
+	tokenNumber
+	tokenParameter
+	tokenPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func (t token) isKeyword(keywords ...string) bool {
+	if t.kind != tokenIdent {
+		return false
+	}
+	for _, keyword := range keywords {
+		if strings.EqualFold(t.text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t token) isPunct(punct string) bool {
+	return t.kind == tokenPunct && t.text == punct
+}
+
+func (t token) isName() bool {
+	return t.kind == tokenQuotedIdent || (t.kind == tokenIdent && !reserved[strings.ToUpper(t.text)])
+}
+
+var multiCharPunct = []string{"::", "=>", "->", "<=", ">=", "<>", "!=", "||"}
+
+func tokenize(query string, dialect Dialect) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(query) {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+		case strings.HasPrefix(query[i:], "--") || (c == '#' && dialect.hasHashComments()) || (dialect == Snowflake && strings.HasPrefix(query[i:], "//")):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				i = len(query)
+			} else {
+				i += end + 1
+			}
+		case strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment at offset %d", i)
+			}
+			i += end + 4
+		case c == '<' && isTableauParameter(query[i:]):
+			end := strings.IndexByte(query[i:], '>')
+			tokens = append(tokens, token{kind: tokenParameter, text: query[i : i+end+1]})
+			i += end + 1
+		case c == '?' || (c == '@' && dialect != Snowflake):
+			j := i + 1
+			for j < len(query) && isIdentifierPart(query[j]) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenParameter, text: query[i:j]})
+			i = j
+		case dialect == Snowflake && strings.HasPrefix(query[i:], "$$"):
+			end := strings.Index(query[i+2:], "$$")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: query[i+2 : i+2+end]})
+			i += end + 4
+		case dialect.isStringQuote(c):
+			text, n, err := readQuoted(query[i:], c, true, dialect == BigQuery)
+			if err != nil {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text})
+			i += n
+		case dialect.isIdentifierQuote(c):
+			text, n, err := readQuoted(query[i:], c, false, false)
+			if err != nil {
+				return nil, fmt.Errorf("unterminated quoted identifier at offset %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenQuotedIdent, text: text})
+			i += n
+		case isDigit(c) || (c == '.' && i+1 < len(query) && isDigit(query[i+1])):
+			j := i + 1
+			for j < len(query) && (isDigit(query[j]) || query[j] == '.' || query[j] == 'e' || query[j] == 'E') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: query[i:j]})
+			i = j
+		case isIdentifierStart(c):
+			j := i + 1
+			for j < len(query) && isIdentifierPart(query[j]) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: query[i:j]})
+			i = j
+		default:
+			text := string(c)
+			for _, punct := range multiCharPunct {
+				if strings.HasPrefix(query[i:], punct) {
+					text = punct
+					break
+				}
+			}
+			tokens = append(tokens, token{kind: tokenPunct, text: text})
+			i += len(text)
+		}
+	}
+	return tokens, nil
+}
+
+// isTableauParameter recognises parameters Tableau substitutes into custom SQL, e.g. `<Parameters.Start Date>` or `<[Parameters].[Start Date]>`.
+func isTableauParameter(s string) bool {
+	lower := strings.ToLower(s)
+	if !strings.HasPrefix(lower, "<parameters.") && !strings.HasPrefix(lower, "<[parameters]") {
+		return false
+	}
+	end := strings.IndexByte(s, '>')
+	return end > 0 && !strings.ContainsRune(s[:end], '\n')
+}
+
+// readQuoted reads a quoted literal starting at s[0], handling doubled quotes and, for strings, backslash escapes
+// and BigQuery's triple quoted strings.
+func readQuoted(s string, quote byte, backslashEscapes, tripleQuotes bool) (string, int, error) {
+	if tripleQuotes && strings.HasPrefix(s, strings.Repeat(string(quote), 3)) {
+		end := strings.Index(s[3:], strings.Repeat(string(quote), 3))
+		if end < 0 {
+			return "", 0, fmt.Errorf("unterminated")
+		}
+		return s[3 : 3+end], end + 6, nil
+	}
+	var b strings.Builder
+	i := 1
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == '\\' && backslashEscapes && i+1 < len(s):
+			b.WriteByte(s[i+1])
+			i += 2
+		case c == quote && i+1 < len(s) && s[i+1] == quote:
+			b.WriteByte(quote)
+			i += 2
+		case c == quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c) || c == '$'
+}
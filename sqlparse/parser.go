@@ -0,0 +1,759 @@
+package sqlparse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Table struct {
+	Database string `json:"database,omitempty"`
+	Schema   string `json:"schema,omitempty"`
+	Name     string `json:"name"`
+}
+
+func (t Table) FullName() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{t.Database, t.Schema, t.Name} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+type Column struct {
+	Table Table  `json:"table"`
+	Name  string `json:"name"`
+}
+
+type Options struct {
+	// DefaultDatabase qualifies tables referenced without a database (project for BigQuery)
+	DefaultDatabase string
+	// DefaultSchema qualifies tables referenced without a schema (dataset for BigQuery)
+	DefaultSchema string
+}
+
+type Result struct {
+	Dialect           Dialect  `json:"dialect"`
+	Tables            []Table  `json:"tables"`
+	Columns           []Column `json:"columns"`
+	UnresolvedColumns []string `json:"unresolvedColumns,omitempty"`
+	// Confident is false when the query could not be fully parsed and tables were extracted on a best effort basis
+	Confident bool   `json:"confident"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Parse extracts warehouse tables and columns referenced by a custom SQL query.
+func Parse(query string, dialect Dialect, opts Options) *Result {
+	p := &parser{
+		dialect:    dialect,
+		opts:       opts,
+		result:     &Result{Dialect: dialect, Tables: []Table{}, Columns: []Column{}},
+		seen:       map[string]bool{},
+		unresolved: map[string]bool{},
+	}
+
+	err := p.parse(query)
+	if err != nil {
+		p.result.Error = err.Error()
+		p.fallback()
+	} else {
+		p.result.Confident = true
+	}
+
+	return p.result
+}
+
+type source struct {
+	alias string
+	parts []string
+	// table is nil for subqueries, CTEs and table functions
+	table *Table
+}
+
+type scope struct {
+	parent  *scope
+	ctes    []string
+	sources []*source
+	// aliases are select list aliases, which may be referenced by later clauses
+	aliases []string
+	// pending are ranges of expressions in the FROM clause, scanned once all sources are known
+	pending [][2]int
+}
+
+type parser struct {
+	dialect    Dialect
+	opts       Options
+	tokens     []token
+	match      []int
+	result     *Result
+	seen       map[string]bool
+	unresolved map[string]bool
+}
+
+func (p *parser) parse(query string) error {
+	tokens, err := tokenize(query, p.dialect)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("empty query")
+	}
+	p.tokens = tokens
+	if err := p.matchBrackets(); err != nil {
+		return err
+	}
+
+	start := 0
+	for i := 0; i <= len(p.tokens); i++ {
+		if i < len(p.tokens) && !p.tokens[i].isPunct(";") {
+			if p.match[i] > i {
+				i = p.match[i]
+			}
+			continue
+		}
+		if i > start {
+			if err := p.parseQuery(start, i, nil); err != nil {
+				return err
+			}
+		}
+		start = i + 1
+	}
+	return nil
+}
+
+func (p *parser) matchBrackets() error {
+	closing := map[string]string{"(": ")", "[": "]", "{": "}"}
+	p.match = make([]int, len(p.tokens))
+	var stack []int
+	for i, t := range p.tokens {
+		p.match[i] = -1
+		if t.kind != tokenPunct {
+			continue
+		}
+		switch t.text {
+		case "(", "[", "{":
+			stack = append(stack, i)
+		case ")", "]", "}":
+			if len(stack) == 0 || closing[p.tokens[stack[len(stack)-1]].text] != t.text {
+				return fmt.Errorf("unbalanced %q", t.text)
+			}
+			open := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			p.match[open] = i
+			p.match[i] = open
+		}
+	}
+	if len(stack) > 0 {
+		return fmt.Errorf("unbalanced %q", p.tokens[stack[len(stack)-1]].text)
+	}
+	return nil
+}
+
+func (p *parser) parseQuery(start, end int, outer *scope) error {
+	sc := &scope{parent: outer}
+	i := start
+	if i < end && p.tokens[i].isKeyword("WITH") {
+		i++
+		if i < end && p.tokens[i].isKeyword("RECURSIVE") {
+			i++
+		}
+		for {
+			if i >= end || !p.tokens[i].isName() {
+				return fmt.Errorf("expected common table expression name")
+			}
+			sc.ctes = append(sc.ctes, p.name(p.tokens[i]))
+			i++
+			if i < end && p.tokens[i].isPunct("(") {
+				i = p.match[i] + 1
+			}
+			if i >= end || !p.tokens[i].isKeyword("AS") {
+				return fmt.Errorf("expected AS in common table expression")
+			}
+			i++
+			for i < end && p.tokens[i].isKeyword("NOT", "MATERIALIZED") {
+				i++
+			}
+			if i >= end || !p.tokens[i].isPunct("(") {
+				return fmt.Errorf("expected ( in common table expression")
+			}
+			if err := p.parseQuery(i+1, p.match[i], sc); err != nil {
+				return err
+			}
+			i = p.match[i] + 1
+			if i < end && p.tokens[i].isPunct(",") {
+				i++
+				continue
+			}
+			break
+		}
+	}
+	return p.parseSetExpression(i, end, sc)
+}
+
+func (p *parser) parseSetExpression(start, end int, sc *scope) error {
+	i := start
+	for {
+		j := i
+		for j < end && !p.isSetOperator(j) {
+			j = p.next(j)
+		}
+		if err := p.parseSetOperand(i, j, sc); err != nil {
+			return err
+		}
+		if j >= end {
+			return nil
+		}
+		i = j + 1
+		for i < end && p.tokens[i].isKeyword("ALL", "DISTINCT", "BY", "NAME") {
+			i++
+		}
+	}
+}
+
+func (p *parser) parseSetOperand(start, end int, sc *scope) error {
+	if start >= end {
+		return fmt.Errorf("expected SELECT")
+	}
+	t := p.tokens[start]
+	switch {
+	case t.isPunct("(") && p.isQueryStart(start+1):
+		close := p.match[start]
+		if err := p.parseQuery(start+1, close, sc); err != nil {
+			return err
+		}
+		return p.scanExpression(close+1, end, sc, false, false)
+	case t.isKeyword("SELECT"):
+		return p.parseSelect(start, end, sc)
+	case t.isKeyword("VALUES"):
+		return p.scanExpression(start+1, end, sc, false, false)
+	default:
+		return fmt.Errorf("expected SELECT, found %q", t.text)
+	}
+}
+
+var clauseKeywords = []string{"FROM", "WHERE", "GROUP", "HAVING", "QUALIFY", "WINDOW", "ORDER", "LIMIT", "OFFSET", "FETCH", "PREWHERE", "INTO"}
+
+type clause struct {
+	keyword    string
+	start, end int
+}
+
+func (p *parser) parseSelect(start, end int, outer *scope) error {
+	sc := &scope{parent: outer}
+	i := start + 1
+	if i+1 < end && p.tokens[i].isKeyword("AS") && p.tokens[i+1].isKeyword("STRUCT", "VALUE") {
+		i += 2
+	}
+
+	var clauses []clause
+	selectEnd := end
+	for j := i; j < end; j = p.next(j) {
+		if !p.isClauseKeyword(j) {
+			continue
+		}
+		if len(clauses) == 0 {
+			selectEnd = j
+		} else {
+			clauses[len(clauses)-1].end = j
+		}
+		clauses = append(clauses, clause{keyword: strings.ToUpper(p.tokens[j].text), start: j + 1, end: end})
+	}
+
+	for _, c := range clauses {
+		if c.keyword == "FROM" {
+			if err := p.parseFrom(c.start, c.end, sc); err != nil {
+				return err
+			}
+		}
+	}
+	for _, r := range sc.pending {
+		if err := p.scanExpression(r[0], r[1], sc, false, false); err != nil {
+			return err
+		}
+	}
+	if err := p.scanExpression(i, selectEnd, sc, true, false); err != nil {
+		return err
+	}
+	for _, c := range clauses {
+		if c.keyword == "FROM" || c.keyword == "INTO" {
+			continue
+		}
+		if err := p.scanExpression(c.start, c.end, sc, false, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var joinKeywords = []string{"NATURAL", "LEFT", "RIGHT", "FULL", "INNER", "OUTER", "CROSS", "SEMI", "ANTI", "ASOF", "GLOBAL", "ARRAY", "PASTE", "POSITIONAL", "JOIN"}
+
+func (p *parser) parseFrom(start, end int, sc *scope) error {
+	i, err := p.parseTableFactor(start, end, sc)
+	if err != nil {
+		return err
+	}
+	for i < end {
+		if p.tokens[i].isPunct(",") {
+			if i, err = p.parseTableFactor(i+1, end, sc); err != nil {
+				return err
+			}
+			continue
+		}
+
+		arrayJoin := false
+		for i < end && p.isJoinStart(i) && !p.tokens[i].isKeyword("JOIN") {
+			arrayJoin = arrayJoin || p.tokens[i].isKeyword("ARRAY")
+			i++
+		}
+		if i >= end {
+			return fmt.Errorf("expected JOIN")
+		}
+		if !p.tokens[i].isKeyword("JOIN") {
+			return fmt.Errorf("unexpected %q in FROM clause", p.tokens[i].text)
+		}
+		i++
+
+		if arrayJoin {
+			// ClickHouse ARRAY JOIN takes a list of array expressions, each of which may be aliased
+			j := i
+			for j < end && !p.isJoinStart(j) {
+				if p.tokens[j].isKeyword("AS") && j+1 < end {
+					sc.sources = append(sc.sources, &source{alias: p.name(p.tokens[j+1])})
+				}
+				j = p.next(j)
+			}
+			sc.pending = append(sc.pending, [2]int{i, j})
+			i = j
+			continue
+		}
+
+		if i, err = p.parseTableFactor(i, end, sc); err != nil {
+			return err
+		}
+		if i < end && p.tokens[i].isKeyword("ON") {
+			j := i + 1
+			for j < end && !p.isJoinStart(j) && !p.tokens[j].isPunct(",") {
+				j = p.next(j)
+			}
+			sc.pending = append(sc.pending, [2]int{i + 1, j})
+			i = j
+		} else if i < end && p.tokens[i].isKeyword("USING") {
+			i++
+			if i < end && p.tokens[i].isPunct("(") {
+				i = p.match[i] + 1
+			}
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseTableFactor(start, end int, sc *scope) (int, error) {
+	i := start
+	if i < end && p.tokens[i].isKeyword("LATERAL") {
+		i++
+	}
+	if i >= end {
+		return i, fmt.Errorf("expected table in FROM clause")
+	}
+
+	src := &source{}
+	t := p.tokens[i]
+	switch {
+	case t.isPunct("("):
+		close := p.match[i]
+		if p.isQueryStart(i + 1) {
+			if err := p.parseQuery(i+1, close, sc); err != nil {
+				return i, err
+			}
+		} else {
+			// parenthesised join
+			if err := p.parseFrom(i+1, close, sc); err != nil {
+				return i, err
+			}
+			src = nil
+		}
+		i = close + 1
+	case t.kind == tokenIdent || t.kind == tokenQuotedIdent:
+		parts, j := p.readName(i, end)
+		if j < end && p.tokens[j].isPunct("(") {
+			// table function such as UNNEST, FLATTEN or numbers
+			sc.pending = append(sc.pending, [2]int{j + 1, p.match[j]})
+			i = p.match[j] + 1
+			break
+		}
+		src.parts = parts
+		src.alias = parts[len(parts)-1]
+		if !(len(parts) == 1 && sc.isCTE(p.dialect, parts[0])) && !(len(parts) > 1 && sc.lookup(p.dialect, parts[:1]) != nil) {
+			table := p.qualify(parts)
+			p.addTable(table)
+			src.table = &table
+		}
+		i = j
+	case t.kind == tokenParameter:
+		i++
+	default:
+		return i, fmt.Errorf("unexpected %q in FROM clause", t.text)
+	}
+
+modifiers:
+	for i < end {
+		switch {
+		case p.tokens[i].isKeyword("FINAL"):
+			i++
+		case p.tokens[i].isKeyword("SAMPLE", "TABLESAMPLE"):
+			i++
+			for i < end && (p.tokens[i].kind == tokenNumber || p.tokens[i].isKeyword("BERNOULLI", "SYSTEM", "ROW", "BLOCK", "OFFSET") || p.tokens[i].isPunct("/")) {
+				i++
+			}
+			if i < end && p.tokens[i].isPunct("(") {
+				i = p.match[i] + 1
+			}
+		case p.tokens[i].isKeyword("AT", "BEFORE", "CHANGES") && i+1 < end && p.tokens[i+1].isPunct("("):
+			i = p.match[i+1] + 1
+		case p.tokens[i].isKeyword("FOR") && i+3 < end && p.tokens[i+1].isKeyword("SYSTEM_TIME"):
+			// BigQuery FOR SYSTEM_TIME AS OF <timestamp>
+			i += 4
+			for i < end && !p.isJoinStart(i) && !p.tokens[i].isPunct(",") && !p.tokens[i].isKeyword("AS", "ON", "USING") {
+				i = p.next(i)
+			}
+		default:
+			break modifiers
+		}
+	}
+
+	if i < end && p.tokens[i].isKeyword("AS") {
+		i++
+		if i >= end || !p.tokens[i].isName() {
+			return i, fmt.Errorf("expected alias after AS")
+		}
+	}
+	if i < end && p.tokens[i].isName() && !p.isJoinStart(i) {
+		if src != nil {
+			src.alias = p.name(p.tokens[i])
+		}
+		i++
+		if i < end && p.tokens[i].isPunct("(") {
+			i = p.match[i] + 1
+		}
+	}
+	if i+1 < end && p.tokens[i].isKeyword("WITH") && p.tokens[i+1].isKeyword("OFFSET") {
+		i += 2
+		if i < end && p.tokens[i].isKeyword("AS") {
+			i++
+		}
+		if i < end && p.tokens[i].isName() {
+			i++
+		}
+	}
+
+	if src != nil {
+		sc.sources = append(sc.sources, src)
+	}
+	return i, nil
+}
+
+// scanExpression records column references in an expression list, recursing into subqueries.
+func (p *parser) scanExpression(start, end int, sc *scope, collectAliases, skipAliases bool) error {
+	var functionCalls []bool
+	for i := start; i < end; {
+		t := p.tokens[i]
+		switch {
+		case t.isPunct("(") || t.isPunct("["):
+			if t.isPunct("(") && p.isQueryStart(i+1) {
+				if err := p.parseQuery(i+1, p.match[i], sc); err != nil {
+					return err
+				}
+				i = p.match[i] + 1
+				continue
+			}
+			functionCalls = append(functionCalls, i > start && p.tokens[i-1].kind == tokenIdent)
+			i++
+			continue
+		case t.isPunct(")") || t.isPunct("]"):
+			if len(functionCalls) > 0 {
+				functionCalls = functionCalls[:len(functionCalls)-1]
+			}
+			i++
+			continue
+		case t.isKeyword("CURRENT") && i+1 < end && p.tokens[i+1].isKeyword("ROW"):
+			i += 2
+			continue
+		case !t.isName():
+			i++
+			continue
+		}
+
+		parts, j := p.readName(i, end)
+		var prev, next token
+		if i > start {
+			prev = p.tokens[i-1]
+		}
+		if j < end {
+			next = p.tokens[j]
+		}
+		i = j
+
+		switch {
+		case next.isPunct(".") && j+1 < end && p.tokens[j+1].isPunct("*"):
+			i = j + 2
+		case prev.isKeyword("AS") || (prev.text != "" && p.isOperandEnd(prev)):
+			if collectAliases {
+				sc.aliases = append(sc.aliases, parts[0])
+			}
+		case prev.isPunct("::") || prev.isPunct(":") || prev.isKeyword("OVER", "NULLS", "AT"):
+		case next.isPunct("(") || next.isPunct("=>") || next.isPunct("->") || next.kind == tokenString || next.isKeyword("FROM", "ZONE"):
+		case len(parts) == 1 && datePart[strings.ToUpper(t.text)] && t.kind == tokenIdent &&
+			len(functionCalls) > 0 && functionCalls[len(functionCalls)-1] &&
+			(prev.isPunct("(") || prev.isPunct(",")) && (next.isPunct(")") || next.isPunct(",")):
+		default:
+			p.resolveColumn(parts, sc, skipAliases)
+		}
+	}
+	return nil
+}
+
+func (p *parser) resolveColumn(parts []string, sc *scope, skipAliases bool) {
+	if len(parts) == 1 && skipAliases && sc.hasAlias(p.dialect, parts[0]) {
+		return
+	}
+	if len(parts) > 1 {
+		qualifier, name := parts[:len(parts)-1], parts[len(parts)-1]
+		for s := sc; s != nil; s = s.parent {
+			for _, src := range s.sources {
+				if src.matches(p.dialect, qualifier) {
+					if src.table != nil {
+						p.addColumn(*src.table, name)
+					}
+					return
+				}
+			}
+		}
+	}
+	// an unqualified column, or access to a field of a struct column
+	for s := sc; s != nil; s = s.parent {
+		switch len(s.sources) {
+		case 0:
+			continue
+		case 1:
+			if s.sources[0].table != nil {
+				p.addColumn(*s.sources[0].table, parts[0])
+			}
+			return
+		default:
+			p.addUnresolved(strings.Join(parts, "."))
+			return
+		}
+	}
+}
+
+// fallback extracts table names following FROM and JOIN when the query could not be parsed.
+func (p *parser) fallback() {
+	if p.tokens == nil {
+		return
+	}
+	ctes := map[string]bool{}
+	for i := 0; i+2 < len(p.tokens); i++ {
+		if p.tokens[i].isName() && p.tokens[i+1].isKeyword("AS") && p.tokens[i+2].isPunct("(") {
+			ctes[strings.ToUpper(p.name(p.tokens[i]))] = true
+		}
+	}
+	for i := 0; i+1 < len(p.tokens); i++ {
+		if !p.tokens[i].isKeyword("FROM", "JOIN") {
+			continue
+		}
+		t := p.tokens[i+1]
+		if t.kind != tokenIdent && t.kind != tokenQuotedIdent {
+			continue
+		}
+		parts, j := p.readName(i+1, len(p.tokens))
+		if j < len(p.tokens) && p.tokens[j].isPunct("(") {
+			continue
+		}
+		if len(parts) == 1 && ctes[strings.ToUpper(parts[0])] {
+			continue
+		}
+		p.addTable(p.qualify(parts))
+	}
+}
+
+// readName reads a possibly qualified name such as `db.schema.table`.
+func (p *parser) readName(start, end int) ([]string, int) {
+	var parts []string
+	i := start
+	for {
+		t := p.tokens[i]
+		if p.dialect == BigQuery && t.kind == tokenQuotedIdent {
+			parts = append(parts, strings.Split(t.text, ".")...)
+		} else {
+			parts = append(parts, p.name(t))
+		}
+		i++
+		for i+1 < end && p.tokens[i].isPunct(".") && p.tokens[i+1].isPunct(".") {
+			// Snowflake `db..table` uses the default schema
+			parts = append(parts, "")
+			i++
+		}
+		if i+1 < end && p.tokens[i].isPunct(".") && (p.tokens[i+1].kind == tokenIdent || p.tokens[i+1].kind == tokenQuotedIdent) {
+			i++
+			continue
+		}
+		return parts, i
+	}
+}
+
+func (p *parser) name(t token) string {
+	return p.dialect.Fold(t.text, t.kind == tokenQuotedIdent)
+}
+
+func (p *parser) qualify(parts []string) Table {
+	n := len(parts)
+	var table Table
+	switch {
+	case !p.dialect.hasSchemas() && n == 1:
+		table = Table{Database: p.opts.DefaultDatabase, Name: parts[0]}
+	case !p.dialect.hasSchemas():
+		table = Table{Database: parts[n-2], Name: parts[n-1]}
+	case n == 1:
+		table = Table{Database: p.opts.DefaultDatabase, Schema: p.opts.DefaultSchema, Name: parts[0]}
+	case n == 2:
+		table = Table{Database: p.opts.DefaultDatabase, Schema: parts[0], Name: parts[1]}
+	default:
+		table = Table{Database: parts[n-3], Schema: parts[n-2], Name: parts[n-1]}
+	}
+	if table.Schema == "" && p.dialect.hasSchemas() {
+		table.Schema = p.opts.DefaultSchema
+	}
+	return table
+}
+
+func (p *parser) addTable(table Table) {
+	key := "t:" + table.FullName()
+	if p.seen[key] {
+		return
+	}
+	p.seen[key] = true
+	p.result.Tables = append(p.result.Tables, table)
+}
+
+func (p *parser) addColumn(table Table, name string) {
+	key := "c:" + table.FullName() + "." + name
+	if p.seen[key] {
+		return
+	}
+	p.seen[key] = true
+	p.result.Columns = append(p.result.Columns, Column{Table: table, Name: name})
+}
+
+func (p *parser) addUnresolved(name string) {
+	if p.unresolved[name] {
+		return
+	}
+	p.unresolved[name] = true
+	p.result.UnresolvedColumns = append(p.result.UnresolvedColumns, name)
+}
+
+// next returns the index of the token following the one at i, skipping over bracketed groups.
+func (p *parser) next(i int) int {
+	if p.match[i] > i {
+		return p.match[i] + 1
+	}
+	return i + 1
+}
+
+func (p *parser) isQueryStart(i int) bool {
+	for i < len(p.tokens) && p.tokens[i].isPunct("(") {
+		i++
+	}
+	return i < len(p.tokens) && p.tokens[i].isKeyword("SELECT", "WITH")
+}
+
+func (p *parser) isSetOperator(i int) bool {
+	t := p.tokens[i]
+	if t.isKeyword("EXCEPT") {
+		// BigQuery `SELECT * EXCEPT (column)`
+		return i == 0 || !p.tokens[i-1].isPunct("*")
+	}
+	return t.isKeyword("UNION", "INTERSECT", "MINUS")
+}
+
+func (p *parser) isClauseKeyword(i int) bool {
+	t := p.tokens[i]
+	switch {
+	case t.isKeyword("GROUP"):
+		return i == 0 || !p.tokens[i-1].isKeyword("WITHIN")
+	case t.isKeyword("FROM"):
+		return i == 0 || !p.tokens[i-1].isKeyword("DISTINCT")
+	case t.isKeyword("OFFSET"):
+		// BigQuery `UNNEST(array) WITH OFFSET`
+		return i == 0 || !p.tokens[i-1].isKeyword("WITH")
+	case t.isKeyword("SETTINGS", "FORMAT"):
+		return p.dialect == ClickHouse
+	}
+	return t.isKeyword(clauseKeywords...)
+}
+
+func (p *parser) isJoinStart(i int) bool {
+	if !p.tokens[i].isKeyword(joinKeywords...) {
+		return false
+	}
+	// LEFT(...) and RIGHT(...) are functions
+	return i+1 >= len(p.tokens) || !p.tokens[i+1].isPunct("(")
+}
+
+func (p *parser) isOperandEnd(t token) bool {
+	switch t.kind {
+	case tokenQuotedIdent, tokenString, tokenNumber, tokenParameter:
+		return true
+	case tokenIdent:
+		return t.isName() || t.isKeyword("END", "NULL", "TRUE", "FALSE")
+	}
+	return t.isPunct(")") || t.isPunct("]")
+}
+
+func (s *scope) isCTE(dialect Dialect, name string) bool {
+	for ; s != nil; s = s.parent {
+		for _, cte := range s.ctes {
+			if dialect.equalIdentifiers(cte, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *scope) lookup(dialect Dialect, qualifier []string) *source {
+	for ; s != nil; s = s.parent {
+		for _, src := range s.sources {
+			if src.matches(dialect, qualifier) {
+				return src
+			}
+		}
+	}
+	return nil
+}
+
+func (s *scope) hasAlias(dialect Dialect, name string) bool {
+	for _, alias := range s.aliases {
+		if dialect.equalIdentifiers(alias, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *source) matches(dialect Dialect, qualifier []string) bool {
+	if len(qualifier) == 1 && s.alias != "" {
+		return dialect.equalIdentifiers(s.alias, qualifier[0])
+	}
+	if len(qualifier) > len(s.parts) {
+		return false
+	}
+	offset := len(s.parts) - len(qualifier)
+	for i, part := range qualifier {
+		if !dialect.equalIdentifiers(s.parts[offset+i], part) {
+			return false
+		}
+	}
+	return true
+}
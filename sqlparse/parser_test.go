@@ -0,0 +1,104 @@
+package sqlparse
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   Dialect
+		opts      Options
+		query     string
+		tables    []string
+		columns   []string
+		confident bool
+	}{
+		{
+			name:      "snowflake folds unquoted identifiers",
+			dialect:   Snowflake,
+			opts:      Options{DefaultDatabase: "ANALYTICS", DefaultSchema: "PUBLIC"},
+			query:     `select o.id, o.amount, "Mixed" from orders o where o.status = 'paid'`,
+			tables:    []string{"ANALYTICS.PUBLIC.ORDERS"},
+			columns:   []string{"ANALYTICS.PUBLIC.ORDERS.ID", "ANALYTICS.PUBLIC.ORDERS.AMOUNT", "ANALYTICS.PUBLIC.ORDERS.Mixed", "ANALYTICS.PUBLIC.ORDERS.STATUS"},
+			confident: true,
+		},
+		{
+			name:    "bigquery backtick table and join",
+			dialect: BigQuery,
+			opts:    Options{DefaultDatabase: "my-project"},
+			query: "SELECT c.name, SUM(o.amount) AS total\n" +
+				"FROM `my-project.shop.orders` AS o\n" +
+				"JOIN shop.customers c ON c.id = o.customer_id\n" +
+				"WHERE DATE_TRUNC(o.created_at, MONTH) = DATE '2023-01-01'\n" +
+				"GROUP BY 1 ORDER BY total DESC",
+			tables:    []string{"my-project.shop.orders", "my-project.shop.customers"},
+			columns:   []string{"my-project.shop.customers.id", "my-project.shop.orders.customer_id", "my-project.shop.customers.name", "my-project.shop.orders.amount", "my-project.shop.orders.created_at"},
+			confident: true,
+		},
+		{
+			name:    "ctes and subqueries are not tables",
+			dialect: Redshift,
+			opts:    Options{DefaultDatabase: "dev"},
+			query: `WITH recent AS (SELECT id, user_id FROM Public.Events WHERE ts > dateadd(day, -7, getdate()))
+				SELECT r.user_id, u.email FROM recent r
+				LEFT JOIN (SELECT id, email FROM public.users) u ON u.id = r.user_id
+				UNION ALL
+				SELECT user_id, NULL FROM archive.events;`,
+			tables:    []string{"dev.public.events", "dev.public.users", "dev.archive.events"},
+			columns:   []string{"dev.public.events.id", "dev.public.events.user_id", "dev.public.events.ts", "dev.public.users.id", "dev.public.users.email", "dev.archive.events.user_id"},
+			confident: true,
+		},
+		{
+			name:      "clickhouse database qualified table",
+			dialect:   ClickHouse,
+			opts:      Options{DefaultDatabase: "default"},
+			query:     "SELECT `event_type`, count() FROM analytics.events FINAL PREWHERE date >= today() - 7 GROUP BY event_type",
+			tables:    []string{"analytics.events"},
+			columns:   []string{"analytics.events.event_type", "analytics.events.date"},
+			confident: true,
+		},
+		{
+			name:      "tableau parameters are literals",
+			dialect:   Snowflake,
+			query:     `SELECT * FROM RAW.SALES WHERE REGION = <Parameters.Region>`,
+			tables:    []string{"RAW.SALES"},
+			columns:   []string{"RAW.SALES.REGION"},
+			confident: true,
+		},
+		{
+			name:      "unparseable statement falls back to FROM scanning",
+			dialect:   Snowflake,
+			query:     `SELECT a FROM db.s.t WHERE (`,
+			tables:    []string{"DB.S.T"},
+			columns:   []string{},
+			confident: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.query, tt.dialect, tt.opts)
+			if got.Confident != tt.confident {
+				t.Errorf("Parse() confident = %v, want %v (error: %s)", got.Confident, tt.confident, got.Error)
+			}
+			tables := make([]string, 0)
+			for _, table := range got.Tables {
+				tables = append(tables, table.FullName())
+			}
+			if !reflect.DeepEqual(tables, tt.tables) {
+				t.Errorf("Parse() tables = %v, want %v", tables, tt.tables)
+			}
+			columns := make([]string, 0)
+			for _, column := range got.Columns {
+				columns = append(columns, column.Table.FullName()+"."+column.Name)
+			}
+			sort.Strings(columns)
+			sort.Strings(tt.columns)
+			if !reflect.DeepEqual(columns, tt.columns) {
+				t.Errorf("Parse() columns = %v, want %v", columns, tt.columns)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/getsynq/connections-tableau/internal"
+	"github.com/getsynq/connections-tableau/upload"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var UploadKey string
+
+var uploadCmd = &cobra.Command{
+	Use:   "upload <export>",
+	Short: "Upload an export to an ingestion endpoint, e.g. again after a failed upload",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := uploadExport(cmd.Context(), args[0], UploadKey, os.Stdout); err != nil {
+			return errors.Wrapf(err, "failed to upload export %s", args[0])
+		}
+		return nil
+	},
+}
+
+func init() {
+	addUploadFlags(uploadCmd)
+	uploadCmd.Flags().StringVar(&UploadKey, "idempotency-key", "", "Idempotency key of the upload, defaults to the hash of the export")
+	uploadCmd.Flags().IntVar(&Retries.MaxRetries, "max-retries", Retries.MaxRetries, "How many times a chunk failing with a network error, 429 or 5xx is retried")
+	uploadCmd.Flags().BoolVarP(&internal.Verbose, "verbose", "v", false, "Report retries")
+	uploadCmd.MarkFlagRequired("upload-url")
+	rootCmd.AddCommand(uploadCmd)
+}
+
+func addUploadFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Upload.Endpoint, "upload-url", "", "Ingestion endpoint to upload the export to in chunks")
+	cmd.Flags().StringVar(&Upload.Token, "upload-token", "", "API token sent as bearer token to --upload-url")
+	cmd.Flags().IntVar(&UploadChunkSize, "upload-chunk-size", upload.DefaultChunkSize>>20, "Size in MiB of the chunks sent to --upload-url")
+}
+
+// uploadExport sends the export at path to the ingestion endpoint, retrying chunks which failed.
+func uploadExport(ctx context.Context, path, key string, progress io.Writer) error {
+	Upload.ChunkSize = UploadChunkSize << 20
+	Upload.HttpClient = &http.Client{Transport: internal.NewRetryTransport(http.DefaultTransport, internal.RetryOptions{
+		MaxRetries: Retries.MaxRetries,
+		MinBackoff: Retries.MinBackoff,
+		MaxBackoff: Retries.MaxBackoff,
+		Timeout:    Retries.Timeout,
+	})}
+	chunks, err := Upload.File(ctx, path, key)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(progress, "Uploaded %s in %d chunks to %s\n", path, chunks, Upload.Endpoint)
+	return nil
+}
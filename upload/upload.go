@@ -0,0 +1,128 @@
+// Package upload sends exports to an ingestion endpoint, split into chunks which are posted one by one.
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Headers of every chunk, the endpoint reassembles the export from the chunks of an idempotency key
+// once it received ChunkCount of them and checks the result against ContentSha256.
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	HeaderChunkIndex     = "X-Chunk-Index"
+	HeaderChunkCount     = "X-Chunk-Count"
+	HeaderFileName       = "X-File-Name"
+	HeaderContentSha256  = "X-Content-Sha256"
+)
+
+// DefaultChunkSize keeps requests well below the body limits of common proxies.
+const DefaultChunkSize = 8 << 20
+
+// Client uploads exports in chunks. Chunks failing with a network error, 429 or 5xx are retried by the
+// transport of HttpClient, and are sent with the same idempotency key so the endpoint can ignore
+// chunks it already received.
+type Client struct {
+	Endpoint string
+	// Token is sent as bearer token unless empty.
+	Token      string
+	ChunkSize  int
+	HttpClient *http.Client
+}
+
+// RunKey is the idempotency key of the export of a crawl, which is the same for every attempt to
+// upload it.
+func RunKey(url, site string, extractedAt time.Time) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\n%s\n%s", url, site, extractedAt.UTC().Format(time.RFC3339Nano))))
+	return hex.EncodeToString(sum[:16])
+}
+
+// File uploads the file at path and returns the number of chunks sent. Without key the file is
+// uploaded with the hash of its content as idempotency key.
+func (c *Client) File(ctx context.Context, path, key string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return 0, err
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if key == "" {
+		key = sum[:32]
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	chunkSize := c.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	count := int((info.Size() + int64(chunkSize) - 1) / int64(chunkSize))
+	if count == 0 {
+		// an empty export is still uploaded, as a single empty chunk
+		count = 1
+	}
+
+	buf := make([]byte, chunkSize)
+	for index := 0; index < count; index++ {
+		n, err := io.ReadFull(f, buf)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return index, err
+		}
+		headers := http.Header{}
+		headers.Set(HeaderIdempotencyKey, key)
+		headers.Set(HeaderChunkIndex, strconv.Itoa(index))
+		headers.Set(HeaderChunkCount, strconv.Itoa(count))
+		headers.Set(HeaderFileName, filepath.Base(path))
+		headers.Set(HeaderContentSha256, sum)
+		if err := c.post(ctx, headers, buf[:n]); err != nil {
+			return index, fmt.Errorf("failed to upload chunk %d of %d: %w", index+1, count, err)
+		}
+	}
+	return count, nil
+}
+
+func (c *Client) post(ctx context.Context, headers http.Header, chunk []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Endpoint, bytes.NewReader(chunk))
+	if err != nil {
+		return err
+	}
+	req.Header = headers
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if c.Token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	}
+
+	resp, err := c.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// the endpoint already has this chunk, e.g. when a response was lost and the chunk sent again
+	if resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("server responded with status code: %d - %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
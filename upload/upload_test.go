@@ -0,0 +1,129 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getsynq/connections-tableau/internal"
+)
+
+// standIn is a local ingestion endpoint which fails the first attempt of every chunk and reassembles
+// the uploads by idempotency key.
+type standIn struct {
+	mu       sync.Mutex
+	attempts map[string]int
+	chunks   map[string]map[int][]byte
+	headers  http.Header
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	key := r.Header.Get(HeaderIdempotencyKey)
+	index, _ := strconv.Atoi(r.Header.Get(HeaderChunkIndex))
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.headers = r.Header
+	attempt := key + "/" + strconv.Itoa(index)
+	s.attempts[attempt]++
+	if s.attempts[attempt] == 1 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if _, ok := s.chunks[key][index]; ok {
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	if s.chunks[key] == nil {
+		s.chunks[key] = map[int][]byte{}
+	}
+	s.chunks[key][index] = body
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *standIn) upload(key string) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var buf bytes.Buffer
+	for i := 0; i < len(s.chunks[key]); i++ {
+		buf.Write(s.chunks[key][i])
+	}
+	return buf.Bytes()
+}
+
+func TestFile(t *testing.T) {
+	server := &standIn{attempts: map[string]int{}, chunks: map[string]map[int][]byte{}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	content := bytes.Repeat([]byte("0123456789"), 25)
+	path := filepath.Join(t.TempDir(), "tables-site.json")
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &Client{
+		Endpoint:  ts.URL,
+		Token:     "secret",
+		ChunkSize: 100,
+		HttpClient: &http.Client{Transport: internal.NewRetryTransport(http.DefaultTransport, internal.RetryOptions{
+			MaxRetries: 2,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: time.Millisecond,
+		})},
+	}
+	key := RunKey("https://tableau.example.com", "site", time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+	for i := 0; i < 2; i++ {
+		// uploading again with the same key is accepted as the chunks are already there
+		chunks, err := client.File(context.Background(), path, key)
+		if err != nil {
+			t.Fatalf("File() error = %v", err)
+		}
+		if chunks != 3 {
+			t.Errorf("File() = %d chunks, want 3", chunks)
+		}
+	}
+
+	if got := server.upload(key); !bytes.Equal(got, content) {
+		t.Errorf("uploaded %q, want %q", got, content)
+	}
+	sum := sha256.Sum256(content)
+	if got := server.headers.Get(HeaderContentSha256); got != hex.EncodeToString(sum[:]) {
+		t.Errorf("%s = %s", HeaderContentSha256, got)
+	}
+	if got := server.headers.Get(HeaderFileName); got != "tables-site.json" {
+		t.Errorf("%s = %s", HeaderFileName, got)
+	}
+}
+
+func TestFileRejected(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("invalid token"))
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "tables.json")
+	if err := os.WriteFile(path, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{Endpoint: ts.URL, Token: "wrong", HttpClient: ts.Client()}
+	if _, err := client.File(context.Background(), path, ""); err == nil {
+		t.Error("File() error = nil, want an error")
+	}
+}
@@ -0,0 +1,217 @@
+// Package warehouse normalises the names Tableau reports for warehouse tables into canonical
+// database.schema.table identifiers, which match the names in the warehouse's own catalog.
+package warehouse
+
+import (
+	"strings"
+
+	"github.com/getsynq/connections-tableau/sqlparse"
+)
+
+// Identifier is the canonical name of a warehouse table. Schema is empty for ClickHouse, which has
+// no schemas, and Database when Tableau does not know it.
+type Identifier struct {
+	Database string `json:"database,omitempty"`
+	Schema   string `json:"schema,omitempty"`
+	Name     string `json:"name"`
+}
+
+// String joins the parts with dots, quoting those which contain dots or quotes themselves.
+func (i Identifier) String() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{i.Database, i.Schema, i.Name} {
+		if part == "" {
+			continue
+		}
+		if strings.ContainsAny(part, `."`) {
+			part = `"` + strings.ReplaceAll(part, `"`, `""`) + `"`
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Normalize turns the fullName of a table, e.g. [project].[dataset].[table], "DB"."SCHEMA"."T" or a
+// bare name, into its canonical identifier. database is the name of the table's database in Tableau
+// and used for names which are not qualified with one.
+//
+// Unquoted names are case folded like the warehouse does (upper case for Snowflake, lower case for
+// Redshift). Brackets only delimit the parts in Tableau and are folded like unquoted names, except for
+// SQL Server where they quote names. BigQuery project ids are lower case and, for connectors which
+// report the server as database, database names which are really its host are dropped.
+func Normalize(connectionType, database, fullName string) Identifier {
+	return resolve(connectionType, database, fullName, true)
+}
+
+// Split resolves the parts of fullName like Normalize, but keeps them in the case Tableau reports.
+func Split(connectionType, database, fullName string) Identifier {
+	return resolve(connectionType, database, fullName, false)
+}
+
+func resolve(connectionType, database, fullName string, fold bool) Identifier {
+	dialect := sqlparse.DialectForConnectionType(connectionType)
+	parts := split(dialect, bracketQuotes[strings.ToLower(connectionType)], fullName)
+	value := func(p part) string {
+		if !fold {
+			return p.value
+		}
+		return dialect.Fold(p.value, p.quoted)
+	}
+
+	var id Identifier
+	var db part
+	switch n := len(parts); {
+	case n == 1:
+		id.Name = value(parts[0])
+	case n == 2 && dialect == sqlparse.ClickHouse:
+		db = parts[0]
+		id.Name = value(parts[1])
+	case n == 2:
+		id.Schema = value(parts[0])
+		id.Name = value(parts[1])
+	case n >= 3:
+		db = parts[n-3]
+		id.Schema = value(parts[n-2])
+		id.Name = value(parts[n-1])
+	}
+	if db.value == "" {
+		db = part{value: databaseName(connectionType, database)}
+	}
+	id.Database = value(db)
+	if fold && dialect == sqlparse.BigQuery {
+		id.Database = strings.ToLower(id.Database)
+	}
+	return id
+}
+
+// hostDatabases are the connection types for which Tableau can report the server as database. Others,
+// e.g. BigQuery with domain scoped projects like example.com:project, have dotted database names.
+var hostDatabases = map[string]bool{
+	"snowflake": true,
+	"redshift":  true,
+}
+
+// bracketQuotes are the connection types whose SQL quotes names in brackets, which keep their case.
+var bracketQuotes = map[string]bool{
+	"sqlserver": true,
+}
+
+// databaseName is the name of a Tableau database, which for some connectors is the server it is on.
+// The database of a table on a server is unknown, its host is not the name of any database.
+func databaseName(connectionType, database string) string {
+	if !hostDatabases[strings.ToLower(connectionType)] {
+		return database
+	}
+	if isHost(database) {
+		return ""
+	}
+	return database
+}
+
+// isHost reports whether name is the address of a server: a host name with a scheme or port, or with
+// at least three labels like cluster.example.com.
+func isHost(name string) bool {
+	address := false
+	if i := strings.Index(name, "://"); i >= 0 {
+		name, address = name[i+3:], true
+	}
+	name = strings.TrimSuffix(name, "/")
+	if i := strings.LastIndexByte(name, ':'); i >= 0 && isDigits(name[i+1:]) {
+		name, address = name[:i], true
+	}
+	name = strings.ToLower(name)
+
+	labels := strings.Split(name, ".")
+	if len(labels) < 3 && !address {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || strings.Trim(label, "abcdefghijklmnopqrstuvwxyz0123456789-") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+type part struct {
+	value string
+	// quoted parts are quoted in SQL and not case folded, delimited ones are in any delimiters
+	quoted    bool
+	delimited bool
+}
+
+// split splits a dotted name, removing the brackets, double quotes or backticks around its parts.
+// Double quotes and backticks quote a part, brackets only when bracketsQuote is set, otherwise they
+// are how Tableau displays names.
+// BigQuery names can be quoted as a whole, `project.dataset.table`, and use project:dataset in
+// legacy SQL.
+func split(dialect sqlparse.Dialect, bracketsQuote bool, fullName string) []part {
+	var parts []part
+	var current part
+	for i := 0; i < len(fullName); i++ {
+		c := fullName[i]
+		var closing byte
+		switch c {
+		case '[':
+			closing = ']'
+		case '"', '`':
+			closing = c
+		case '.':
+			parts = append(parts, current)
+			current = part{}
+			continue
+		default:
+			current.value += string(c)
+			continue
+		}
+		current.quoted = c != '[' || bracketsQuote
+		current.delimited = true
+		// a doubled closing character escapes itself
+		for i++; i < len(fullName); i++ {
+			if fullName[i] == closing {
+				if i+1 < len(fullName) && fullName[i+1] == closing {
+					i++
+				} else {
+					break
+				}
+			}
+			current.value += string(fullName[i])
+		}
+	}
+	if fullName != "" {
+		parts = append(parts, current)
+	}
+
+	if dialect != sqlparse.BigQuery {
+		return parts
+	}
+	var expanded []part
+	for _, p := range parts {
+		if p.delimited && len(parts) == 1 && strings.Contains(p.value, ".") {
+			value := p.value
+			// the dots of a domain scoped project, example.com:project, do not separate parts
+			if i := strings.IndexByte(value, ':'); i >= 0 {
+				j := strings.IndexByte(value[i:], '.')
+				if j < 0 {
+					j = len(value) - i
+				}
+				expanded = append(expanded, part{value: value[:i+j], quoted: p.quoted, delimited: true})
+				value = strings.TrimPrefix(value[i+j:], ".")
+			}
+			for _, v := range strings.Split(value, ".") {
+				expanded = append(expanded, part{value: v, quoted: p.quoted, delimited: true})
+			}
+			continue
+		}
+		if i := strings.LastIndexByte(p.value, ':'); i >= 0 && len(expanded) == 0 && len(parts) == 2 {
+			expanded = append(expanded, part{value: p.value[:i], quoted: p.quoted}, part{value: p.value[i+1:], quoted: p.quoted})
+			continue
+		}
+		expanded = append(expanded, p)
+	}
+	return expanded
+}
@@ -0,0 +1,72 @@
+package warehouse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getsynq/connections-tableau/sqlparse"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		connectionType string
+		database       string
+		fullName       string
+		want           string
+	}{
+		{"snowflake", "analytics", "[PUBLIC].[ORDERS]", "ANALYTICS.PUBLIC.ORDERS"},
+		{"snowflake", "analytics", "[public].[orders]", "ANALYTICS.PUBLIC.ORDERS"},
+		{"snowflake", "", `"ANALYTICS"."public"."Orders"`, "ANALYTICS.public.Orders"},
+		{"snowflake", "analytics", "public.orders", "ANALYTICS.PUBLIC.ORDERS"},
+		{"snowflake", "acme.eu-west-1.snowflakecomputing.com", "[PUBLIC].[ORDERS]", "PUBLIC.ORDERS"},
+		{"bigquery", "", "[My-Project].[dataset].[Orders]", "my-project.dataset.Orders"},
+		{"bigquery", "my-project", "[dataset].[orders]", "my-project.dataset.orders"},
+		{"bigquery", "", "`my-project.dataset.orders`", "my-project.dataset.orders"},
+		{"bigquery", "", "`example.com:project.dataset.orders`", `"example.com:project".dataset.orders`},
+		{"bigquery", "", "my-project:dataset.orders", "my-project.dataset.orders"},
+		{"redshift", "DEV", `[Public].[Orders]`, "dev.public.orders"},
+		// the cluster is not the database, which is unknown
+		{"redshift", "prod-cluster.abc123.eu-west-1.redshift.amazonaws.com:5439", "[public].[orders]", "public.orders"},
+		{"redshift", "prod-cluster", "[public].[orders]", "prod-cluster.public.orders"},
+		{"redshift", "10.0.0.1:5439", "[public].[orders]", "public.orders"},
+		{"clickhouse", "default", "[events].[clicks]", "events.clicks"},
+		{"clickhouse", "default", "clicks", "default.clicks"},
+		{"clickhouse", "default", "[Events].[Clicks]", "Events.Clicks"},
+		{"clickhouse", "default", `"Events"."Clicks"`, "Events.Clicks"},
+		{"sqlserver", "sales", "[dbo].[order.lines]", `sales.dbo."order.lines"`},
+		{"sqlserver", "Sales", "[Sales].[DBO].[Orders]", "Sales.DBO.Orders"},
+		{"postgres", "shop", "orders", "shop.orders"},
+		{"postgres", "sales.eu.prod", "[public].[orders]", `"sales.eu.prod".public.orders`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.connectionType+" "+tt.fullName, func(t *testing.T) {
+			if got := Normalize(tt.connectionType, tt.database, tt.fullName).String(); got != tt.want {
+				t.Errorf("Normalize() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitBrackets(t *testing.T) {
+	tests := []struct {
+		connectionType string
+		quoted         bool
+	}{
+		// brackets quote names in SQL Server and keep their case
+		{"sqlserver", true},
+		{"SQLServer", true},
+		// elsewhere they are how Tableau displays names and folded like unquoted ones
+		{"snowflake", false},
+		{"postgres", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.connectionType, func(t *testing.T) {
+			dialect := sqlparse.DialectForConnectionType(tt.connectionType)
+			for _, p := range split(dialect, bracketQuotes[strings.ToLower(tt.connectionType)], "[dbo].[Orders]") {
+				if p.quoted != tt.quoted || !p.delimited {
+					t.Errorf("split() part %s quoted = %v, delimited = %v, want quoted %v", p.value, p.quoted, p.delimited, tt.quoted)
+				}
+			}
+		})
+	}
+}